@@ -76,8 +76,10 @@ import (
 	az "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/httpclient"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/terraform-providers/terraform-provider-azurerm/version"
 )
@@ -93,43 +95,72 @@ type ArmClient struct {
 	environment              az.Environment
 	skipProviderRegistration bool
 
-	StopContext context.Context
-
-	cosmosDBClient documentdb.DatabaseAccountsClient
-
-	automationAccountClient               automation.AccountClient
-	automationAgentRegistrationInfoClient automation.AgentRegistrationInformationClient
-	automationCredentialClient            automation.CredentialClient
-	automationDscConfigurationClient      automation.DscConfigurationClient
-	automationDscNodeConfigurationClient  automation.DscNodeConfigurationClient
-	automationModuleClient                automation.ModuleClient
-	automationRunbookClient               automation.RunbookClient
-	automationRunbookDraftClient          automation.RunbookDraftClient
-	automationScheduleClient              automation.ScheduleClient
+	// defaultTags are merged into the tags of every resource which supports them, and
+	// tags whose key begins with one of the ignoreTagPrefixes are ignored
+	defaultTags       map[string]interface{}
+	ignoreTagPrefixes []string
 
-	dnsClient   dns.RecordSetsClient
-	zonesClient dns.ZonesClient
+	// session is the authenticated session shared by the clients for each Subscription
+	session *armSession
 
-	containerRegistryClient             containerregistry.RegistriesClient
-	containerRegistryReplicationsClient containerregistry.ReplicationsClient
-	containerServicesClient             containerservice.ContainerServicesClient
-	kubernetesClustersClient            containerservice.ManagedClustersClient
-	containerGroupsClient               containerinstance.ContainerGroupsClient
+	// resourceProviders are the Resource Providers known to be registered within this Subscription
+	resourceProviders resourceProviderRegistrations
 
-	eventGridDomainsClient            eventgrid.DomainsClient
-	eventGridEventSubscriptionsClient eventgrid.EventSubscriptionsClient
-	eventGridTopicsClient             eventgrid.TopicsClient
-	eventHubClient                    eventhub.EventHubsClient
-	eventHubConsumerGroupClient       eventhub.ConsumerGroupsClient
-	eventHubNamespacesClient          eventhub.NamespacesClient
+	StopContext context.Context
 
-	solutionsClient operationsmanagement.SolutionsClient
+	// the clients for each service, which are built on first use by the accessor for that service (e.g. `compute()`)
+	apiManagementClients       apiManagementClients
+	appInsightsClients         appInsightsClients
+	automationClients          automationClients
+	authenticationClients      authenticationClients
+	batchClients               batchClients
+	cdnClients                 cdnClients
+	cognitiveServicesClients   cognitiveServicesClients
+	computeClients             computeClients
+	containerInstanceClients   containerInstanceClients
+	containerRegistryClients   containerRegistryClients
+	containerServicesClients   containerServicesClients
+	cosmosDBClients            cosmosDBClients
+	databricksClients          databricksClients
+	databasesClients           databasesClients
+	dataFactoryClients         dataFactoryClients
+	dataLakeClients            dataLakeClients
+	devicesClients             devicesClients
+	devSpaceClients            devSpaceClients
+	devTestClients             devTestClients
+	dnsClients                 dnsClients
+	eventGridClients           eventGridClients
+	eventHubClients            eventHubClients
+	hdinsightClients           hdinsightClients
+	keyVaultClients            keyVaultClients
+	logicClients               logicClients
+	mediaClients               mediaClients
+	monitorClients             monitorClients
+	networkClients             networkClients
+	notificationHubsClients    notificationHubsClients
+	operationalInsightsClients operationalInsightsClients
+	recoveryServicesClients    recoveryServicesClients
+	policyClients              policyClients
+	managementGroupsClients    managementGroupsClients
+	redisClients               redisClients
+	relayClients               relayClients
+	resourcesClients           resourcesClients
+	searchClients              searchClients
+	securityCenterClients      securityCenterClients
+	serviceBusClients          serviceBusClients
+	serviceFabricClients       serviceFabricClients
+	schedulerClients           schedulerClients
+	signalRClients             signalRClients
+	storageClients             storageClients
+	streamAnalyticsClients     streamAnalyticsClients
+	trafficManagerClients      trafficManagerClients
+	webClients                 webClients
+}
 
-	redisClient               redis.Client
-	redisFirewallClient       redis.FirewallRulesClient
-	redisPatchSchedulesClient redis.PatchSchedulesClient
+// apiManagementClients are the clients for API Management, see `apiManagement()`
+type apiManagementClients struct {
+	once sync.Once
 
-	// API Management
 	apiManagementApiClient                  apimanagement.APIClient
 	apiManagementApiOperationsClient        apimanagement.APIOperationClient
 	apiManagementApiVersionSetClient        apimanagement.APIVersionSetClient
@@ -149,34 +180,70 @@ type ArmClient struct {
 	apiManagementSignUpClient               apimanagement.SignUpSettingsClient
 	apiManagementSubscriptionsClient        apimanagement.SubscriptionClient
 	apiManagementUsersClient                apimanagement.UserClient
+}
+
+// appInsightsClients are the clients for Application Insights, see `appInsights()`
+type appInsightsClients struct {
+	once sync.Once
 
-	// Application Insights
 	appInsightsClient       appinsights.ComponentsClient
 	appInsightsAPIKeyClient appinsights.APIKeysClient
+}
+
+// automationClients are the clients for Automation, see `automation()`
+type automationClients struct {
+	once sync.Once
+
+	automationAccountClient               automation.AccountClient
+	automationAgentRegistrationInfoClient automation.AgentRegistrationInformationClient
+	automationCredentialClient            automation.CredentialClient
+	automationDscConfigurationClient      automation.DscConfigurationClient
+	automationDscNodeConfigurationClient  automation.DscNodeConfigurationClient
+	automationModuleClient                automation.ModuleClient
+	automationRunbookClient               automation.RunbookClient
+	automationRunbookDraftClient          automation.RunbookDraftClient
+	automationScheduleClient              automation.ScheduleClient
+}
+
+// authenticationClients are the clients for Authorization and Azure Active Directory, see `authentication()`
+type authenticationClients struct {
+	once sync.Once
 
-	// Authentication
 	roleAssignmentsClient   authorization.RoleAssignmentsClient
 	roleDefinitionsClient   authorization.RoleDefinitionsClient
 	applicationsClient      graphrbac.ApplicationsClient
 	servicePrincipalsClient graphrbac.ServicePrincipalsClient
+}
 
-	// Autoscale Settings
-	autoscaleSettingsClient insights.AutoscaleSettingsClient
+// batchClients are the clients for Batch, see `batch()`
+type batchClients struct {
+	once sync.Once
 
-	// Batch
 	batchAccountClient     batch.AccountClient
 	batchCertificateClient batch.CertificateClient
 	batchPoolClient        batch.PoolClient
+}
+
+// cdnClients are the clients for CDN, see `cdn()`
+type cdnClients struct {
+	once sync.Once
 
-	// CDN
 	cdnCustomDomainsClient cdn.CustomDomainsClient
 	cdnEndpointsClient     cdn.EndpointsClient
 	cdnProfilesClient      cdn.ProfilesClient
+}
+
+// cognitiveServicesClients are the clients for Cognitive Services, see `cognitiveServices()`
+type cognitiveServicesClients struct {
+	once sync.Once
 
-	// Cognitive Services
 	cognitiveAccountsClient cognitiveservices.AccountsClient
+}
+
+// computeClients are the clients for Compute, see `compute()`
+type computeClients struct {
+	once sync.Once
 
-	// Compute
 	availSetClient             compute.AvailabilitySetsClient
 	diskClient                 compute.DisksClient
 	imageClient                compute.ImagesClient
@@ -190,20 +257,49 @@ type ArmClient struct {
 	vmScaleSetClient           compute.VirtualMachineScaleSetsClient
 	vmImageClient              compute.VirtualMachineImagesClient
 	vmClient                   compute.VirtualMachinesClient
+}
 
-	// Devices
-	iothubResourceClient devices.IotHubResourceClient
+// containerInstanceClients are the clients for Container Instances, see `containerInstance()`
+type containerInstanceClients struct {
+	once sync.Once
 
-	// DevTestLabs
-	devTestLabsClient            dtl.LabsClient
-	devTestPoliciesClient        dtl.PoliciesClient
-	devTestVirtualMachinesClient dtl.VirtualMachinesClient
-	devTestVirtualNetworksClient dtl.VirtualNetworksClient
+	containerGroupsClient containerinstance.ContainerGroupsClient
+}
 
-	// DevSpace
-	devSpaceControllerClient devspaces.ControllersClient
+// containerRegistryClients are the clients for Container Registry, see `containerRegistry()`
+type containerRegistryClients struct {
+	once sync.Once
+
+	containerRegistryClient             containerregistry.RegistriesClient
+	containerRegistryReplicationsClient containerregistry.ReplicationsClient
+}
+
+// containerServicesClients are the clients for Container Services, see `containerServices()`
+type containerServicesClients struct {
+	once sync.Once
+
+	containerServicesClient  containerservice.ContainerServicesClient
+	kubernetesClustersClient containerservice.ManagedClustersClient
+}
+
+// cosmosDBClients are the clients for CosmosDB, see `cosmosDB()`
+type cosmosDBClients struct {
+	once sync.Once
+
+	cosmosDBClient documentdb.DatabaseAccountsClient
+}
+
+// databricksClients are the clients for Databricks, see `databricks()`
+type databricksClients struct {
+	once sync.Once
+
+	databricksWorkspacesClient databricks.WorkspacesClient
+}
+
+// databasesClients are the clients for Databases, see `databases()`
+type databasesClients struct {
+	once sync.Once
 
-	// Databases
 	mariadbDatabasesClient                   mariadb.DatabasesClient
 	mariadbServersClient                     mariadb.ServersClient
 	mysqlConfigurationsClient                mysql.ConfigurationsClient
@@ -225,49 +321,115 @@ type ArmClient struct {
 	sqlServersClient                     sql.ServersClient
 	sqlServerAzureADAdministratorsClient sql.ServerAzureADAdministratorsClient
 	sqlVirtualNetworkRulesClient         sql.VirtualNetworkRulesClient
+}
+
+// dataFactoryClients are the clients for Data Factory, see `dataFactory()`
+type dataFactoryClients struct {
+	once sync.Once
 
-	// Data Factory
 	dataFactoryPipelineClient      datafactory.PipelinesClient
 	dataFactoryClient              datafactory.FactoriesClient
 	dataFactoryDatasetClient       datafactory.DatasetsClient
 	dataFactoryLinkedServiceClient datafactory.LinkedServicesClient
+}
 
-	// Data Lake Store
-	dataLakeStoreAccountClient       storeAccount.AccountsClient
-	dataLakeStoreFirewallRulesClient storeAccount.FirewallRulesClient
-	dataLakeStoreFilesClient         filesystem.Client
+// dataLakeClients are the clients for Data Lake, see `dataLake()`
+type dataLakeClients struct {
+	once sync.Once
 
-	// Data Lake Analytics
+	dataLakeStoreAccountClient           storeAccount.AccountsClient
+	dataLakeStoreFirewallRulesClient     storeAccount.FirewallRulesClient
+	dataLakeStoreFilesClient             filesystem.Client
 	dataLakeAnalyticsAccountClient       analyticsAccount.AccountsClient
 	dataLakeAnalyticsFirewallRulesClient analyticsAccount.FirewallRulesClient
+}
 
-	// Databricks
-	databricksWorkspacesClient databricks.WorkspacesClient
+// devicesClients are the clients for Devices, see `devices()`
+type devicesClients struct {
+	once sync.Once
+
+	iothubResourceClient devices.IotHubResourceClient
+}
+
+// devSpaceClients are the clients for DevSpaces, see `devSpace()`
+type devSpaceClients struct {
+	once sync.Once
+
+	devSpaceControllerClient devspaces.ControllersClient
+}
+
+// devTestClients are the clients for DevTest Labs, see `devTest()`
+type devTestClients struct {
+	once sync.Once
+
+	devTestLabsClient            dtl.LabsClient
+	devTestPoliciesClient        dtl.PoliciesClient
+	devTestVirtualMachinesClient dtl.VirtualMachinesClient
+	devTestVirtualNetworksClient dtl.VirtualNetworksClient
+}
+
+// dnsClients are the clients for DNS, see `dns()`
+type dnsClients struct {
+	once sync.Once
+
+	dnsClient   dns.RecordSetsClient
+	zonesClient dns.ZonesClient
+}
+
+// eventGridClients are the clients for EventGrid, see `eventGrid()`
+type eventGridClients struct {
+	once sync.Once
+
+	eventGridDomainsClient            eventgrid.DomainsClient
+	eventGridEventSubscriptionsClient eventgrid.EventSubscriptionsClient
+	eventGridTopicsClient             eventgrid.TopicsClient
+}
+
+// eventHubClients are the clients for EventHub, see `eventHub()`
+type eventHubClients struct {
+	once sync.Once
+
+	eventHubClient              eventhub.EventHubsClient
+	eventHubConsumerGroupClient eventhub.ConsumerGroupsClient
+	eventHubNamespacesClient    eventhub.NamespacesClient
+}
+
+// hdinsightClients are the clients for HDInsight, see `hdinsight()`
+type hdinsightClients struct {
+	once sync.Once
 
-	// HDInsight
 	hdinsightApplicationsClient   hdinsight.ApplicationsClient
 	hdinsightClustersClient       hdinsight.ClustersClient
 	hdinsightConfigurationsClient hdinsight.ConfigurationsClient
+}
+
+// keyVaultClients are the clients for KeyVault, see `keyVault()`
+type keyVaultClients struct {
+	once sync.Once
 
-	// KeyVault
 	keyVaultClient           keyvault.VaultsClient
 	keyVaultManagementClient keyVault.BaseClient
+}
 
-	// Log Analytics
-	linkedServicesClient operationalinsights.LinkedServicesClient
-	workspacesClient     operationalinsights.WorkspacesClient
+// logicClients are the clients for Logic, see `logic()`
+type logicClients struct {
+	once sync.Once
 
-	// Logic
 	logicWorkflowsClient logic.WorkflowsClient
+}
 
-	// Management Groups
-	managementGroupsClient             managementgroups.Client
-	managementGroupsSubscriptionClient managementgroups.SubscriptionsClient
+// mediaClients are the clients for Media Services, see `media()`
+type mediaClients struct {
+	once sync.Once
 
-	// Media
 	mediaServicesClient media.MediaservicesClient
+}
+
+// monitorClients are the clients for Monitor, see `monitor()`
+type monitorClients struct {
+	once sync.Once
 
-	// Monitor
+	autoscaleSettingsClient                 insights.AutoscaleSettingsClient
 	monitorActionGroupsClient               insights.ActionGroupsClient
 	monitorActivityLogAlertsClient          insights.ActivityLogAlertsClient
 	monitorAlertRulesClient                 insights.AlertRulesClient
@@ -275,106 +437,238 @@ type ArmClient struct {
 	monitorDiagnosticSettingsCategoryClient insights.DiagnosticSettingsCategoryClient
 	monitorLogProfilesClient                insights.LogProfilesClient
 	monitorMetricAlertsClient               insights.MetricAlertsClient
+}
 
-	// MSI
-	userAssignedIdentitiesClient msi.UserAssignedIdentitiesClient
+// networkClients are the clients for Networking, see `network()`
+type networkClients struct {
+	once sync.Once
 
-	// Networking
+	userAssignedIdentitiesClient    msi.UserAssignedIdentitiesClient
 	applicationGatewayClient        network.ApplicationGatewaysClient
 	applicationSecurityGroupsClient network.ApplicationSecurityGroupsClient
+	azureFirewallFqdnTagsClient     network.AzureFirewallFqdnTagsClient
 	azureFirewallsClient            network.AzureFirewallsClient
 	connectionMonitorsClient        network.ConnectionMonitorsClient
+	ddosCustomPoliciesClient        network.DdosCustomPoliciesClient
 	ddosProtectionPlanClient        network.DdosProtectionPlansClient
 	expressRouteAuthsClient         network.ExpressRouteCircuitAuthorizationsClient
 	expressRouteCircuitClient       network.ExpressRouteCircuitsClient
 	expressRoutePeeringsClient      network.ExpressRouteCircuitPeeringsClient
+	expressRouteConnectionsClient   network.ExpressRouteConnectionsClient
+	expressRouteGatewaysClient      network.ExpressRouteGatewaysClient
+	expressRoutePortsClient         network.ExpressRoutePortsClient
+	hubVnetConnectionsClient        network.HubVirtualNetworkConnectionsClient
 	ifaceClient                     network.InterfacesClient
+	ifaceTapConfigurationsClient    network.InterfaceTapConfigurationsClient
+	interfaceEndpointsClient        network.InterfaceEndpointsClient
 	loadBalancerClient              network.LoadBalancersClient
 	localNetConnClient              network.LocalNetworkGatewaysClient
+	networkProfilesClient           network.ProfilesClient
 	packetCapturesClient            network.PacketCapturesClient
+	p2sVpnGatewaysClient            network.P2sVpnGatewaysClient
+	p2sVpnServerConfigsClient       network.P2sVpnServerConfigurationsClient
 	publicIPClient                  network.PublicIPAddressesClient
 	publicIPPrefixClient            network.PublicIPPrefixesClient
+	routeFiltersClient              network.RouteFiltersClient
+	routeFilterRulesClient          network.RouteFilterRulesClient
 	routesClient                    network.RoutesClient
 	routeTablesClient               network.RouteTablesClient
 	secGroupClient                  network.SecurityGroupsClient
 	secRuleClient                   network.SecurityRulesClient
+	serviceEndpointPoliciesClient   network.ServiceEndpointPoliciesClient
 	subnetClient                    network.SubnetsClient
 	vnetGatewayConnectionsClient    network.VirtualNetworkGatewayConnectionsClient
 	vnetGatewayClient               network.VirtualNetworkGatewaysClient
 	vnetClient                      network.VirtualNetworksClient
 	vnetPeeringsClient              network.VirtualNetworkPeeringsClient
+	vnetTapsClient                  network.VirtualNetworkTapsClient
+	virtualHubsClient               network.VirtualHubsClient
+	virtualWansClient               network.VirtualWansClient
+	vpnConnectionsClient            network.VpnConnectionsClient
+	vpnGatewaysClient               network.VpnGatewaysClient
+	vpnSitesClient                  network.VpnSitesClient
+	vpnSitesConfigurationClient     network.VpnSitesConfigurationClient
 	watcherClient                   network.WatchersClient
+	wafPoliciesClient               network.WebApplicationFirewallPoliciesClient
+}
+
+// notificationHubsClients are the clients for Notification Hubs, see `notificationHubs()`
+type notificationHubsClients struct {
+	once sync.Once
 
-	// Notification Hubs
 	notificationHubsClient       notificationhubs.Client
 	notificationNamespacesClient notificationhubs.NamespacesClient
+}
+
+// operationalInsightsClients are the clients for Log Analytics, see `operationalInsights()`
+type operationalInsightsClients struct {
+	once sync.Once
+
+	solutionsClient      operationsmanagement.SolutionsClient
+	linkedServicesClient operationalinsights.LinkedServicesClient
+	workspacesClient     operationalinsights.WorkspacesClient
+}
+
+// recoveryServicesClients are the clients for Recovery Services, see `recoveryServices()`
+type recoveryServicesClients struct {
+	once sync.Once
 
-	// Recovery Services
 	recoveryServicesVaultsClient             recoveryservices.VaultsClient
 	recoveryServicesProtectedItemsClient     backup.ProtectedItemsGroupClient
 	recoveryServicesProtectionPoliciesClient backup.ProtectionPoliciesClient
+}
+
+// policyClients are the clients for Policy, see `policy()`
+type policyClients struct {
+	once sync.Once
+
+	policyAssignmentsClient    policy.AssignmentsClient
+	policyDefinitionsClient    policy.DefinitionsClient
+	policySetDefinitionsClient policy.SetDefinitionsClient
+}
+
+// managementGroupsClients are the clients for Management Groups, see `managementGroups()`
+type managementGroupsClients struct {
+	once sync.Once
+
+	managementGroupsClient             managementgroups.Client
+	managementGroupsSubscriptionClient managementgroups.SubscriptionsClient
+}
+
+// redisClients are the clients for Redis, see `redis()`
+type redisClients struct {
+	once sync.Once
+
+	redisClient               redis.Client
+	redisFirewallClient       redis.FirewallRulesClient
+	redisPatchSchedulesClient redis.PatchSchedulesClient
+}
+
+// relayClients are the clients for Relay, see `relay()`
+type relayClients struct {
+	once sync.Once
 
-	// Relay
 	relayNamespacesClient relay.NamespacesClient
+}
+
+// resourcesClients are the clients for Resources, see `resources()`
+type resourcesClients struct {
+	once sync.Once
 
-	// Resources
 	managementLocksClient locks.ManagementLocksClient
 	deploymentsClient     resources.DeploymentsClient
 	providersClient       resourcesprofile.ProvidersClient
 	resourcesClient       resources.Client
 	resourceGroupsClient  resources.GroupsClient
 	subscriptionsClient   subscriptions.Client
+}
 
-	// Scheduler
-	schedulerJobCollectionsClient scheduler.JobCollectionsClient //nolint: megacheck
-	schedulerJobsClient           scheduler.JobsClient           //nolint: megacheck
+// searchClients are the clients for Search, see `search()`
+type searchClients struct {
+	once sync.Once
 
-	// Search
 	searchServicesClient  search.ServicesClient
 	searchAdminKeysClient search.AdminKeysClient
+}
+
+// securityCenterClients are the clients for Security Center, see `securityCenter()`
+type securityCenterClients struct {
+	once sync.Once
 
-	// Security Centre
 	securityCenterPricingClient   security.PricingsClient
 	securityCenterContactsClient  security.ContactsClient
 	securityCenterWorkspaceClient security.WorkspaceSettingsClient
+}
+
+// serviceBusClients are the clients for ServiceBus, see `serviceBus()`
+type serviceBusClients struct {
+	once sync.Once
 
-	// ServiceBus
 	serviceBusQueuesClient            servicebus.QueuesClient
 	serviceBusNamespacesClient        servicebus.NamespacesClient
 	serviceBusTopicsClient            servicebus.TopicsClient
 	serviceBusSubscriptionsClient     servicebus.SubscriptionsClient
 	serviceBusSubscriptionRulesClient servicebus.RulesClient
+}
+
+// serviceFabricClients are the clients for Service Fabric, see `serviceFabric()`
+type serviceFabricClients struct {
+	once sync.Once
 
-	// Service Fabric
 	serviceFabricClustersClient servicefabric.ClustersClient
+}
+
+// schedulerClients are the clients for Scheduler, see `scheduler()`
+type schedulerClients struct {
+	once sync.Once
+
+	schedulerJobCollectionsClient scheduler.JobCollectionsClient //nolint: megacheck
+	schedulerJobsClient           scheduler.JobsClient           //nolint: megacheck
+}
+
+// signalRClients are the clients for SignalR, see `signalR()`
+type signalRClients struct {
+	once sync.Once
 
-	// SignalR
 	signalRClient signalr.Client
+}
+
+// storageClients are the clients for Storage, see `storage()`
+type storageClients struct {
+	once sync.Once
 
-	// Storage
 	storageServiceClient storage.AccountsClient
 	storageUsageClient   storage.UsageClient
+}
+
+// streamAnalyticsClients are the clients for Stream Analytics, see `streamAnalytics()`
+type streamAnalyticsClients struct {
+	once sync.Once
 
-	// Stream Analytics
 	streamAnalyticsFunctionsClient       streamanalytics.FunctionsClient
 	streamAnalyticsJobsClient            streamanalytics.StreamingJobsClient
 	streamAnalyticsInputsClient          streamanalytics.InputsClient
 	streamAnalyticsOutputsClient         streamanalytics.OutputsClient
 	streamAnalyticsTransformationsClient streamanalytics.TransformationsClient
+}
+
+// trafficManagerClients are the clients for Traffic Manager, see `trafficManager()`
+type trafficManagerClients struct {
+	once sync.Once
 
-	// Traffic Manager
 	trafficManagerGeographialHierarchiesClient trafficmanager.GeographicHierarchiesClient
 	trafficManagerProfilesClient               trafficmanager.ProfilesClient
 	trafficManagerEndpointsClient              trafficmanager.EndpointsClient
+}
+
+// webClients are the clients for Web, see `web()`
+type webClients struct {
+	once sync.Once
 
-	// Web
 	appServicePlansClient web.AppServicePlansClient
 	appServicesClient     web.AppsClient
+}
 
-	// Policy
-	policyAssignmentsClient    policy.AssignmentsClient
-	policyDefinitionsClient    policy.DefinitionsClient
-	policySetDefinitionsClient policy.SetDefinitionsClient
+// armSession is the authenticated session (that is, the Authorizers and Sender) which is shared
+// between the ArmClients for each Subscription
+type armSession struct {
+	endpoint      string
+	graphEndpoint string
+
+	auth         autorest.Authorizer
+	graphAuth    autorest.Authorizer
+	keyVaultAuth autorest.Authorizer
+	sender       autorest.Sender
+
+	lock                sync.Mutex
+	subscriptionClients map[string]*subscriptionClient
+}
+
+// subscriptionClient lazily builds the ArmClient for a Subscription, such that the session-wide lock is only
+// held whilst looking up (or inserting) the Subscription - rather than whilst the clients are being built
+type subscriptionClient struct {
+	once   sync.Once
+	client *ArmClient
 }
 
 var (
@@ -401,8 +695,14 @@ func (c *ArmClient) configureClient(client *autorest.Client, auth autorest.Autho
 	setUserAgent(client, c.partnerId)
 	client.Authorizer = auth
 	//client.RequestInspector = azure.WithClientID(clientRequestID())
-	client.Sender = azure.BuildSender()
+	client.Sender = c.session.sender
 	client.SkipResourceProviderRegistration = c.skipProviderRegistration
+
+	// the Sender handles retries, which are bounded by `max_retries` and `max_retry_delay_in_seconds`
+	azure.DisableClientRetries(client)
+
+	// NOTE: this is only used when polling with a context which has no deadline - since the
+	// Create/Read/Update/Delete functions bound their context using the resources' Timeouts
 	client.PollingDuration = 60 * time.Minute
 }
 
@@ -427,11 +727,22 @@ func setUserAgent(client *autorest.Client, partnerID string) {
 }
 
 // getArmClient is a helper method which returns a fully instantiated
-// *ArmClient based on the Config's current settings.
-func getArmClient(c *authentication.Config, skipProviderRegistration bool, partnerId string) (*ArmClient, error) {
-	env, err := authentication.DetermineEnvironment(c.Environment)
-	if err != nil {
-		return nil, err
+// *ArmClient based on the Config's current settings - connecting to the
+// specified Azure Environment, which is determined from the Config when nil.
+func getArmClient(c *authentication.Config, env *az.Environment, skipProviderRegistration bool, partnerId string, senderOptions azure.SenderOptions, auxiliaryTenantIds []string) (*ArmClient, error) {
+	if env == nil {
+		determined, err := authentication.DetermineEnvironment(c.Environment)
+		if err != nil {
+			return nil, err
+		}
+		env = determined
+	}
+
+	// the Acceptance Tests can record (or play back) the HTTP Interactions with Azure
+	if session := recording.CurrentSession(); session != nil {
+		environment := session.Environment(*env)
+		env = &environment
+		senderOptions.Decorators = append(senderOptions.Decorators, session.SendDecorator())
 	}
 
 	// client declarations:
@@ -455,11 +766,16 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		return nil, fmt.Errorf("Unable to configure OAuthConfig for tenant %s", c.TenantID)
 	}
 
-	sender := azure.BuildSender()
+	sender := azure.BuildSender(senderOptions)
 
 	// Resource Manager endpoints
 	endpoint := env.ResourceManagerEndpoint
-	auth, err := c.GetAuthorizationToken(sender, oauthConfig, env.TokenAudience)
+	armAuth, err := c.GetAuthorizationToken(sender, oauthConfig, env.TokenAudience)
+	if err != nil {
+		return nil, err
+	}
+
+	auth, err := buildAuxiliaryTenantsAuthorizer(c, env, sender, armAuth, auxiliaryTenantIds)
 	if err != nil {
 		return nil, err
 	}
@@ -481,800 +797,1289 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		return keyVaultSpt, nil
 	})
 
-	client.registerApiManagementServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerAppInsightsClients(endpoint, c.SubscriptionID, auth)
-	client.registerAutomationClients(endpoint, c.SubscriptionID, auth)
-	client.registerAuthentication(endpoint, graphEndpoint, c.SubscriptionID, c.TenantID, auth, graphAuth)
-	client.registerBatchClients(endpoint, c.SubscriptionID, auth)
-	client.registerCDNClients(endpoint, c.SubscriptionID, auth)
-	client.registerCognitiveServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerComputeClients(endpoint, c.SubscriptionID, auth)
-	client.registerContainerInstanceClients(endpoint, c.SubscriptionID, auth)
-	client.registerContainerRegistryClients(endpoint, c.SubscriptionID, auth)
-	client.registerContainerServicesClients(endpoint, c.SubscriptionID, auth)
-	client.registerCosmosDBClients(endpoint, c.SubscriptionID, auth)
-	client.registerDatabricksClients(endpoint, c.SubscriptionID, auth)
-	client.registerDatabases(endpoint, c.SubscriptionID, auth, sender)
-	client.registerDataFactoryClients(endpoint, c.SubscriptionID, auth)
-	client.registerDataLakeStoreClients(endpoint, c.SubscriptionID, auth)
-	client.registerDeviceClients(endpoint, c.SubscriptionID, auth)
-	client.registerDevSpaceClients(endpoint, c.SubscriptionID, auth)
-	client.registerDevTestClients(endpoint, c.SubscriptionID, auth)
-	client.registerDNSClients(endpoint, c.SubscriptionID, auth)
-	client.registerEventGridClients(endpoint, c.SubscriptionID, auth)
-	client.registerEventHubClients(endpoint, c.SubscriptionID, auth)
-	client.registerHDInsightsClients(endpoint, c.SubscriptionID, auth)
-	client.registerKeyVaultClients(endpoint, c.SubscriptionID, auth, keyVaultAuth)
-	client.registerLogicClients(endpoint, c.SubscriptionID, auth)
-	client.registerMediaServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerMonitorClients(endpoint, c.SubscriptionID, auth)
-	client.registerNetworkingClients(endpoint, c.SubscriptionID, auth)
-	client.registerNotificationHubsClient(endpoint, c.SubscriptionID, auth)
-	client.registerOperationalInsightsClients(endpoint, c.SubscriptionID, auth)
-	client.registerRecoveryServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerPolicyClients(endpoint, c.SubscriptionID, auth)
-	client.registerManagementGroupClients(endpoint, auth)
-	client.registerRedisClients(endpoint, c.SubscriptionID, auth)
-	client.registerRelayClients(endpoint, c.SubscriptionID, auth)
-	client.registerResourcesClients(endpoint, c.SubscriptionID, auth)
-	client.registerSearchClients(endpoint, c.SubscriptionID, auth)
-	client.registerSecurityCenterClients(endpoint, c.SubscriptionID, auth)
-	client.registerServiceBusClients(endpoint, c.SubscriptionID, auth)
-	client.registerServiceFabricClients(endpoint, c.SubscriptionID, auth)
-	client.registerSchedulerClients(endpoint, c.SubscriptionID, auth)
-	client.registerSignalRClients(endpoint, c.SubscriptionID, auth)
-	client.registerStorageClients(endpoint, c.SubscriptionID, auth)
-	client.registerStreamAnalyticsClients(endpoint, c.SubscriptionID, auth)
-	client.registerTrafficManagerClients(endpoint, c.SubscriptionID, auth)
-	client.registerWebClients(endpoint, c.SubscriptionID, auth)
+	client.session = &armSession{
+		endpoint:            endpoint,
+		graphEndpoint:       graphEndpoint,
+		auth:                auth,
+		graphAuth:           graphAuth,
+		keyVaultAuth:        keyVaultAuth,
+		sender:              sender,
+		subscriptionClients: make(map[string]*subscriptionClient),
+	}
 
 	return &client, nil
 }
 
+// buildAuxiliaryTenantsAuthorizer returns an Authorizer for Resource Manager which also sends a token for each of the
+// Auxiliary Tenants - which allows cross-tenant operations, such as peering with a Virtual Network in another tenant
+func buildAuxiliaryTenantsAuthorizer(c *authentication.Config, env *az.Environment, sender autorest.Sender, auth autorest.Authorizer, auxiliaryTenantIds []string) (autorest.Authorizer, error) {
+	if len(auxiliaryTenantIds) == 0 {
+		return auth, nil
+	}
+
+	if !c.AuthenticatedAsAServicePrincipal {
+		return nil, fmt.Errorf("Auxiliary Tenants are only supported when authenticating using a Service Principal")
+	}
+
+	auxiliaries := make([]autorest.Authorizer, 0)
+	for _, tenantId := range auxiliaryTenantIds {
+		oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, tenantId)
+		if err != nil {
+			return nil, err
+		}
+
+		if oauthConfig == nil {
+			return nil, fmt.Errorf("Unable to configure OAuthConfig for Auxiliary Tenant %s", tenantId)
+		}
+
+		auxiliaryAuth, err := c.GetAuthorizationToken(sender, oauthConfig, env.TokenAudience)
+		if err != nil {
+			return nil, fmt.Errorf("Error obtaining an Authorization Token for Auxiliary Tenant %q: %+v", tenantId, err)
+		}
+
+		auxiliaries = append(auxiliaries, auxiliaryAuth)
+	}
+
+	return azure.NewAuxiliaryTenantsAuthorizer(auth, auxiliaries)
+}
+
+// clientForSubscription returns the ArmClient for the specified Subscription - which shares the authenticated session
+// (and as such the tokens) of this ArmClient, rather than re-authenticating. Clients for other Subscriptions are created
+// on first use - and, as with this Subscription, Resource Providers are registered as resources require them.
+func (c *ArmClient) clientForSubscription(subscriptionId string) (*ArmClient, error) {
+	if subscriptionId == "" || strings.EqualFold(subscriptionId, c.subscriptionId) {
+		return c, nil
+	}
+
+	key := strings.ToLower(subscriptionId)
+	c.session.lock.Lock()
+	entry, ok := c.session.subscriptionClients[key]
+	if !ok {
+		entry = &subscriptionClient{}
+		c.session.subscriptionClients[key] = entry
+	}
+	c.session.lock.Unlock()
+
+	entry.once.Do(func() {
+		log.Printf("[DEBUG] Configuring the AzureRM Client for Subscription %q", subscriptionId)
+		client := ArmClient{
+			clientId:                 c.clientId,
+			tenantId:                 c.tenantId,
+			subscriptionId:           subscriptionId,
+			partnerId:                c.partnerId,
+			environment:              c.environment,
+			usingServicePrincipal:    c.usingServicePrincipal,
+			skipProviderRegistration: c.skipProviderRegistration,
+			defaultTags:              c.defaultTags,
+			ignoreTagPrefixes:        c.ignoreTagPrefixes,
+			session:                  c.session,
+			StopContext:              c.StopContext,
+		}
+		entry.client = &client
+	})
+
+	return entry.client, nil
+}
+
+// setStopContext replaces the StopContext of this ArmClient, and those of the clients for any other Subscriptions
+func (c *ArmClient) setStopContext(ctx context.Context) {
+	c.StopContext = ctx
+
+	c.session.lock.Lock()
+	defer c.session.lock.Unlock()
+	for _, entry := range c.session.subscriptionClients {
+		if entry.client != nil {
+			entry.client.StopContext = ctx
+		}
+	}
+}
+
+// clientForResource returns the ArmClient for the Subscription the resource belongs to - which is parsed from the
+// Resource ID once the resource exists, otherwise taken from the (optional) `subscription_id` field when it's set
+func (c *ArmClient) clientForResource(d *schema.ResourceData) (*ArmClient, error) {
+	subscriptionId := ""
+	if v, ok := d.GetOk("subscription_id"); ok {
+		subscriptionId = v.(string)
+	}
+
+	if d.Id() != "" {
+		id, err := parseAzureResourceID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		subscriptionId = id.SubscriptionID
+	}
+
+	return c.clientForSubscription(subscriptionId)
+}
+
+// apiManagement returns the clients for API Management, building them on first use
+func (c *ArmClient) apiManagement() *apiManagementClients {
+	c.apiManagementClients.once.Do(func() {
+		c.registerApiManagementServiceClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.apiManagementClients
+}
+
 func (c *ArmClient) registerApiManagementServiceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	apisClient := apimanagement.NewAPIClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&apisClient.Client, auth)
-	c.apiManagementApiClient = apisClient
+	c.apiManagementClients.apiManagementApiClient = apisClient
 
 	apiOperationsClient := apimanagement.NewAPIOperationClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&apiOperationsClient.Client, auth)
-	c.apiManagementApiOperationsClient = apiOperationsClient
+	c.apiManagementClients.apiManagementApiOperationsClient = apiOperationsClient
 
 	apiVersionSetClient := apimanagement.NewAPIVersionSetClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&apiVersionSetClient.Client, auth)
-	c.apiManagementApiVersionSetClient = apiVersionSetClient
+	c.apiManagementClients.apiManagementApiVersionSetClient = apiVersionSetClient
 
 	authorizationServersClient := apimanagement.NewAuthorizationServerClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&authorizationServersClient.Client, auth)
-	c.apiManagementAuthorizationServersClient = authorizationServersClient
+	c.apiManagementClients.apiManagementAuthorizationServersClient = authorizationServersClient
 
 	certificatesClient := apimanagement.NewCertificateClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&certificatesClient.Client, auth)
-	c.apiManagementCertificatesClient = certificatesClient
+	c.apiManagementClients.apiManagementCertificatesClient = certificatesClient
 
 	groupsClient := apimanagement.NewGroupClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&groupsClient.Client, auth)
-	c.apiManagementGroupClient = groupsClient
+	c.apiManagementClients.apiManagementGroupClient = groupsClient
 
 	groupUsersClient := apimanagement.NewGroupUserClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&groupUsersClient.Client, auth)
-	c.apiManagementGroupUsersClient = groupUsersClient
+	c.apiManagementClients.apiManagementGroupUsersClient = groupUsersClient
 
 	loggerClient := apimanagement.NewLoggerClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&loggerClient.Client, auth)
-	c.apiManagementLoggerClient = loggerClient
+	c.apiManagementClients.apiManagementLoggerClient = loggerClient
 
 	policyClient := apimanagement.NewPolicyClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&policyClient.Client, auth)
-	c.apiManagementPolicyClient = policyClient
+	c.apiManagementClients.apiManagementPolicyClient = policyClient
 
 	serviceClient := apimanagement.NewServiceClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&serviceClient.Client, auth)
-	c.apiManagementServiceClient = serviceClient
+	c.apiManagementClients.apiManagementServiceClient = serviceClient
 
 	signInClient := apimanagement.NewSignInSettingsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&signInClient.Client, auth)
-	c.apiManagementSignInClient = signInClient
+	c.apiManagementClients.apiManagementSignInClient = signInClient
 
 	signUpClient := apimanagement.NewSignUpSettingsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&signUpClient.Client, auth)
-	c.apiManagementSignUpClient = signUpClient
+	c.apiManagementClients.apiManagementSignUpClient = signUpClient
 
 	openIdConnectClient := apimanagement.NewOpenIDConnectProviderClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&openIdConnectClient.Client, auth)
-	c.apiManagementOpenIdConnectClient = openIdConnectClient
+	c.apiManagementClients.apiManagementOpenIdConnectClient = openIdConnectClient
 
 	productsClient := apimanagement.NewProductClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&productsClient.Client, auth)
-	c.apiManagementProductsClient = productsClient
+	c.apiManagementClients.apiManagementProductsClient = productsClient
 
 	productApisClient := apimanagement.NewProductAPIClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&productApisClient.Client, auth)
-	c.apiManagementProductApisClient = productApisClient
+	c.apiManagementClients.apiManagementProductApisClient = productApisClient
 
 	productGroupsClient := apimanagement.NewProductGroupClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&productGroupsClient.Client, auth)
-	c.apiManagementProductGroupsClient = productGroupsClient
+	c.apiManagementClients.apiManagementProductGroupsClient = productGroupsClient
 
 	propertiesClient := apimanagement.NewPropertyClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&propertiesClient.Client, auth)
-	c.apiManagementPropertyClient = propertiesClient
+	c.apiManagementClients.apiManagementPropertyClient = propertiesClient
 
 	subscriptionsClient := apimanagement.NewSubscriptionClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&subscriptionsClient.Client, auth)
-	c.apiManagementSubscriptionsClient = subscriptionsClient
+	c.apiManagementClients.apiManagementSubscriptionsClient = subscriptionsClient
 
 	usersClient := apimanagement.NewUserClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&usersClient.Client, auth)
-	c.apiManagementUsersClient = usersClient
+	c.apiManagementClients.apiManagementUsersClient = usersClient
+}
+
+// appInsights returns the clients for Application Insights, building them on first use
+func (c *ArmClient) appInsights() *appInsightsClients {
+	c.appInsightsClients.once.Do(func() {
+		c.registerAppInsightsClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.appInsightsClients
 }
 
 func (c *ArmClient) registerAppInsightsClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	ai := appinsights.NewComponentsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&ai.Client, auth)
-	c.appInsightsClient = ai
+	c.appInsightsClients.appInsightsClient = ai
 
 	aiak := appinsights.NewAPIKeysClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&aiak.Client, auth)
-	c.appInsightsAPIKeyClient = aiak
+	c.appInsightsClients.appInsightsAPIKeyClient = aiak
+}
+
+// automation returns the clients for Automation, building them on first use
+func (c *ArmClient) automation() *automationClients {
+	c.automationClients.once.Do(func() {
+		c.registerAutomationClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.automationClients
 }
 
 func (c *ArmClient) registerAutomationClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	accountClient := automation.NewAccountClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&accountClient.Client, auth)
-	c.automationAccountClient = accountClient
+	c.automationClients.automationAccountClient = accountClient
 
 	agentRegistrationInfoClient := automation.NewAgentRegistrationInformationClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&agentRegistrationInfoClient.Client, auth)
-	c.automationAgentRegistrationInfoClient = agentRegistrationInfoClient
+	c.automationClients.automationAgentRegistrationInfoClient = agentRegistrationInfoClient
 
 	credentialClient := automation.NewCredentialClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&credentialClient.Client, auth)
-	c.automationCredentialClient = credentialClient
+	c.automationClients.automationCredentialClient = credentialClient
 
 	dscConfigurationClient := automation.NewDscConfigurationClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&dscConfigurationClient.Client, auth)
-	c.automationDscConfigurationClient = dscConfigurationClient
+	c.automationClients.automationDscConfigurationClient = dscConfigurationClient
 
 	dscNodeConfigurationClient := automation.NewDscNodeConfigurationClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&dscNodeConfigurationClient.Client, auth)
-	c.automationDscNodeConfigurationClient = dscNodeConfigurationClient
+	c.automationClients.automationDscNodeConfigurationClient = dscNodeConfigurationClient
 
 	moduleClient := automation.NewModuleClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&moduleClient.Client, auth)
-	c.automationModuleClient = moduleClient
+	c.automationClients.automationModuleClient = moduleClient
 
 	runbookClient := automation.NewRunbookClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&runbookClient.Client, auth)
-	c.automationRunbookClient = runbookClient
+	c.automationClients.automationRunbookClient = runbookClient
 
 	scheduleClient := automation.NewScheduleClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&scheduleClient.Client, auth)
-	c.automationScheduleClient = scheduleClient
+	c.automationClients.automationScheduleClient = scheduleClient
 
 	runbookDraftClient := automation.NewRunbookDraftClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&runbookDraftClient.Client, auth)
-	c.automationRunbookDraftClient = runbookDraftClient
+	c.automationClients.automationRunbookDraftClient = runbookDraftClient
+}
+
+// authentication returns the clients for Authorization and Azure Active Directory, building them on first use
+func (c *ArmClient) authentication() *authenticationClients {
+	c.authenticationClients.once.Do(func() {
+		c.registerAuthentication(c.session.endpoint, c.session.graphEndpoint, c.subscriptionId, c.tenantId, c.session.auth, c.session.graphAuth)
+	})
+
+	return &c.authenticationClients
 }
 
 func (c *ArmClient) registerAuthentication(endpoint, graphEndpoint, subscriptionId, tenantId string, auth, graphAuth autorest.Authorizer) {
 	assignmentsClient := authorization.NewRoleAssignmentsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&assignmentsClient.Client, auth)
-	c.roleAssignmentsClient = assignmentsClient
+	c.authenticationClients.roleAssignmentsClient = assignmentsClient
 
 	definitionsClient := authorization.NewRoleDefinitionsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&definitionsClient.Client, auth)
-	c.roleDefinitionsClient = definitionsClient
+	c.authenticationClients.roleDefinitionsClient = definitionsClient
 
 	applicationsClient := graphrbac.NewApplicationsClientWithBaseURI(graphEndpoint, tenantId)
 	c.configureClient(&applicationsClient.Client, graphAuth)
-	c.applicationsClient = applicationsClient
+	c.authenticationClients.applicationsClient = applicationsClient
 
 	servicePrincipalsClient := graphrbac.NewServicePrincipalsClientWithBaseURI(graphEndpoint, tenantId)
 	c.configureClient(&servicePrincipalsClient.Client, graphAuth)
-	c.servicePrincipalsClient = servicePrincipalsClient
+	c.authenticationClients.servicePrincipalsClient = servicePrincipalsClient
+}
+
+// batch returns the clients for Batch, building them on first use
+func (c *ArmClient) batch() *batchClients {
+	c.batchClients.once.Do(func() {
+		c.registerBatchClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.batchClients
 }
 
 func (c *ArmClient) registerBatchClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	batchAccount := batch.NewAccountClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&batchAccount.Client, auth)
-	c.batchAccountClient = batchAccount
+	c.batchClients.batchAccountClient = batchAccount
 
 	batchCertificateClient := batch.NewCertificateClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&batchCertificateClient.Client, auth)
-	c.batchCertificateClient = batchCertificateClient
+	c.batchClients.batchCertificateClient = batchCertificateClient
 
 	batchPool := batch.NewPoolClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&batchPool.Client, auth)
-	c.batchPoolClient = batchPool
+	c.batchClients.batchPoolClient = batchPool
+}
+
+// cdn returns the clients for CDN, building them on first use
+func (c *ArmClient) cdn() *cdnClients {
+	c.cdnClients.once.Do(func() {
+		c.registerCDNClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.cdnClients
 }
 
 func (c *ArmClient) registerCDNClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	customDomainsClient := cdn.NewCustomDomainsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&customDomainsClient.Client, auth)
-	c.cdnCustomDomainsClient = customDomainsClient
+	c.cdnClients.cdnCustomDomainsClient = customDomainsClient
 
 	endpointsClient := cdn.NewEndpointsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&endpointsClient.Client, auth)
-	c.cdnEndpointsClient = endpointsClient
+	c.cdnClients.cdnEndpointsClient = endpointsClient
 
 	profilesClient := cdn.NewProfilesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&profilesClient.Client, auth)
-	c.cdnProfilesClient = profilesClient
+	c.cdnClients.cdnProfilesClient = profilesClient
+}
+
+// cognitiveServices returns the clients for Cognitive Services, building them on first use
+func (c *ArmClient) cognitiveServices() *cognitiveServicesClients {
+	c.cognitiveServicesClients.once.Do(func() {
+		c.registerCognitiveServiceClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.cognitiveServicesClients
 }
 
 func (c *ArmClient) registerCognitiveServiceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	accountsClient := cognitiveservices.NewAccountsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&accountsClient.Client, auth)
-	c.cognitiveAccountsClient = accountsClient
+	c.cognitiveServicesClients.cognitiveAccountsClient = accountsClient
+}
+
+// cosmosDB returns the clients for CosmosDB, building them on first use
+func (c *ArmClient) cosmosDB() *cosmosDBClients {
+	c.cosmosDBClients.once.Do(func() {
+		c.registerCosmosDBClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.cosmosDBClients
 }
 
 func (c *ArmClient) registerCosmosDBClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	cdb := documentdb.NewDatabaseAccountsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&cdb.Client, auth)
-	c.cosmosDBClient = cdb
+	c.cosmosDBClients.cosmosDBClient = cdb
+}
+
+// media returns the clients for Media Services, building them on first use
+func (c *ArmClient) media() *mediaClients {
+	c.mediaClients.once.Do(func() {
+		c.registerMediaServiceClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.mediaClients
 }
 
 func (c *ArmClient) registerMediaServiceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	mediaServicesClient := media.NewMediaservicesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&mediaServicesClient.Client, auth)
-	c.mediaServicesClient = mediaServicesClient
+	c.mediaClients.mediaServicesClient = mediaServicesClient
+}
+
+// compute returns the clients for Compute, building them on first use
+func (c *ArmClient) compute() *computeClients {
+	c.computeClients.once.Do(func() {
+		c.registerComputeClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.computeClients
 }
 
 func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	availabilitySetsClient := compute.NewAvailabilitySetsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&availabilitySetsClient.Client, auth)
-	c.availSetClient = availabilitySetsClient
+	c.computeClients.availSetClient = availabilitySetsClient
 
 	diskClient := compute.NewDisksClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&diskClient.Client, auth)
-	c.diskClient = diskClient
+	c.computeClients.diskClient = diskClient
 
 	imagesClient := compute.NewImagesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&imagesClient.Client, auth)
-	c.imageClient = imagesClient
+	c.computeClients.imageClient = imagesClient
 
 	snapshotsClient := compute.NewSnapshotsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&snapshotsClient.Client, auth)
-	c.snapshotsClient = snapshotsClient
+	c.computeClients.snapshotsClient = snapshotsClient
 
 	usageClient := compute.NewUsageClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&usageClient.Client, auth)
-	c.usageOpsClient = usageClient
+	c.computeClients.usageOpsClient = usageClient
 
 	extensionImagesClient := compute.NewVirtualMachineExtensionImagesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&extensionImagesClient.Client, auth)
-	c.vmExtensionImageClient = extensionImagesClient
+	c.computeClients.vmExtensionImageClient = extensionImagesClient
 
 	extensionsClient := compute.NewVirtualMachineExtensionsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&extensionsClient.Client, auth)
-	c.vmExtensionClient = extensionsClient
+	c.computeClients.vmExtensionClient = extensionsClient
 
 	virtualMachineImagesClient := compute.NewVirtualMachineImagesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&virtualMachineImagesClient.Client, auth)
-	c.vmImageClient = virtualMachineImagesClient
+	c.computeClients.vmImageClient = virtualMachineImagesClient
 
 	scaleSetsClient := compute.NewVirtualMachineScaleSetsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&scaleSetsClient.Client, auth)
-	c.vmScaleSetClient = scaleSetsClient
+	c.computeClients.vmScaleSetClient = scaleSetsClient
 
 	virtualMachinesClient := compute.NewVirtualMachinesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&virtualMachinesClient.Client, auth)
-	c.vmClient = virtualMachinesClient
+	c.computeClients.vmClient = virtualMachinesClient
 
 	galleriesClient := compute.NewGalleriesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&galleriesClient.Client, auth)
-	c.galleriesClient = galleriesClient
+	c.computeClients.galleriesClient = galleriesClient
 
 	galleryImagesClient := compute.NewGalleryImagesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&galleryImagesClient.Client, auth)
-	c.galleryImagesClient = galleryImagesClient
+	c.computeClients.galleryImagesClient = galleryImagesClient
 
 	galleryImageVersionsClient := compute.NewGalleryImageVersionsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&galleryImageVersionsClient.Client, auth)
-	c.galleryImageVersionsClient = galleryImageVersionsClient
+	c.computeClients.galleryImageVersionsClient = galleryImageVersionsClient
+}
+
+// containerInstance returns the clients for Container Instances, building them on first use
+func (c *ArmClient) containerInstance() *containerInstanceClients {
+	c.containerInstanceClients.once.Do(func() {
+		c.registerContainerInstanceClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.containerInstanceClients
 }
 
 func (c *ArmClient) registerContainerInstanceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	cgc := containerinstance.NewContainerGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&cgc.Client, auth)
-	c.containerGroupsClient = cgc
+	c.containerInstanceClients.containerGroupsClient = cgc
+}
+
+// containerRegistry returns the clients for Container Registry, building them on first use
+func (c *ArmClient) containerRegistry() *containerRegistryClients {
+	c.containerRegistryClients.once.Do(func() {
+		c.registerContainerRegistryClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.containerRegistryClients
 }
 
 func (c *ArmClient) registerContainerRegistryClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	crc := containerregistry.NewRegistriesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&crc.Client, auth)
-	c.containerRegistryClient = crc
+	c.containerRegistryClients.containerRegistryClient = crc
 
 	// container registry replicalication client
 	crrc := containerregistry.NewReplicationsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&crrc.Client, auth)
-	c.containerRegistryReplicationsClient = crrc
+	c.containerRegistryClients.containerRegistryReplicationsClient = crrc
+}
+
+// containerServices returns the clients for Container Services, building them on first use
+func (c *ArmClient) containerServices() *containerServicesClients {
+	c.containerServicesClients.once.Do(func() {
+		c.registerContainerServicesClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.containerServicesClients
 }
 
 func (c *ArmClient) registerContainerServicesClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	// ACS
 	containerServicesClient := containerservice.NewContainerServicesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&containerServicesClient.Client, auth)
-	c.containerServicesClient = containerServicesClient
+	c.containerServicesClients.containerServicesClient = containerServicesClient
 
 	// AKS
 	kubernetesClustersClient := containerservice.NewManagedClustersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&kubernetesClustersClient.Client, auth)
-	c.kubernetesClustersClient = kubernetesClustersClient
+	c.containerServicesClients.kubernetesClustersClient = kubernetesClustersClient
+}
+
+// databricks returns the clients for Databricks, building them on first use
+func (c *ArmClient) databricks() *databricksClients {
+	c.databricksClients.once.Do(func() {
+		c.registerDatabricksClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.databricksClients
 }
 
 func (c *ArmClient) registerDatabricksClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	databricksWorkspacesClient := databricks.NewWorkspacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&databricksWorkspacesClient.Client, auth)
-	c.databricksWorkspacesClient = databricksWorkspacesClient
+	c.databricksClients.databricksWorkspacesClient = databricksWorkspacesClient
+}
+
+// databases returns the clients for Databases, building them on first use
+func (c *ArmClient) databases() *databasesClients {
+	c.databasesClients.once.Do(func() {
+		c.registerDatabases(c.session.endpoint, c.subscriptionId, c.session.auth, c.session.sender)
+	})
+
+	return &c.databasesClients
 }
 
 func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
 	mariadbDBClient := mariadb.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&mariadbDBClient.Client, auth)
-	c.mariadbDatabasesClient = mariadbDBClient
+	c.databasesClients.mariadbDatabasesClient = mariadbDBClient
 
 	mariadbServersClient := mariadb.NewServersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&mariadbServersClient.Client, auth)
-	c.mariadbServersClient = mariadbServersClient
+	c.databasesClients.mariadbServersClient = mariadbServersClient
 
 	// MySQL
 	mysqlConfigClient := mysql.NewConfigurationsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&mysqlConfigClient.Client, auth)
-	c.mysqlConfigurationsClient = mysqlConfigClient
+	c.databasesClients.mysqlConfigurationsClient = mysqlConfigClient
 
 	mysqlDBClient := mysql.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&mysqlDBClient.Client, auth)
-	c.mysqlDatabasesClient = mysqlDBClient
+	c.databasesClients.mysqlDatabasesClient = mysqlDBClient
 
 	mysqlFWClient := mysql.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&mysqlFWClient.Client, auth)
-	c.mysqlFirewallRulesClient = mysqlFWClient
+	c.databasesClients.mysqlFirewallRulesClient = mysqlFWClient
 
 	mysqlServersClient := mysql.NewServersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&mysqlServersClient.Client, auth)
-	c.mysqlServersClient = mysqlServersClient
+	c.databasesClients.mysqlServersClient = mysqlServersClient
 
 	mysqlVirtualNetworkRulesClient := mysql.NewVirtualNetworkRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&mysqlVirtualNetworkRulesClient.Client, auth)
-	c.mysqlVirtualNetworkRulesClient = mysqlVirtualNetworkRulesClient
+	c.databasesClients.mysqlVirtualNetworkRulesClient = mysqlVirtualNetworkRulesClient
 
 	// PostgreSQL
 	postgresqlConfigClient := postgresql.NewConfigurationsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&postgresqlConfigClient.Client, auth)
-	c.postgresqlConfigurationsClient = postgresqlConfigClient
+	c.databasesClients.postgresqlConfigurationsClient = postgresqlConfigClient
 
 	postgresqlDBClient := postgresql.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&postgresqlDBClient.Client, auth)
-	c.postgresqlDatabasesClient = postgresqlDBClient
+	c.databasesClients.postgresqlDatabasesClient = postgresqlDBClient
 
 	postgresqlFWClient := postgresql.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&postgresqlFWClient.Client, auth)
-	c.postgresqlFirewallRulesClient = postgresqlFWClient
+	c.databasesClients.postgresqlFirewallRulesClient = postgresqlFWClient
 
 	postgresqlSrvClient := postgresql.NewServersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&postgresqlSrvClient.Client, auth)
-	c.postgresqlServersClient = postgresqlSrvClient
+	c.databasesClients.postgresqlServersClient = postgresqlSrvClient
 
 	postgresqlVNRClient := postgresql.NewVirtualNetworkRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&postgresqlVNRClient.Client, auth)
-	c.postgresqlVirtualNetworkRulesClient = postgresqlVNRClient
+	c.databasesClients.postgresqlVirtualNetworkRulesClient = postgresqlVNRClient
 
 	// SQL Azure
 	sqlDBClient := sql.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlDBClient.Client, auth)
-	c.sqlDatabasesClient = sqlDBClient
+	c.databasesClients.sqlDatabasesClient = sqlDBClient
 
 	sqlDTDPClient := sql.NewDatabaseThreatDetectionPoliciesClientWithBaseURI(endpoint, subscriptionId)
 	setUserAgent(&sqlDTDPClient.Client, "")
 	sqlDTDPClient.Authorizer = auth
 	sqlDTDPClient.Sender = sender
 	sqlDTDPClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.sqlDatabaseThreatDetectionPoliciesClient = sqlDTDPClient
+	azure.DisableClientRetries(&sqlDTDPClient.Client)
+	c.databasesClients.sqlDatabaseThreatDetectionPoliciesClient = sqlDTDPClient
 
 	sqlFWClient := sql.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlFWClient.Client, auth)
-	c.sqlFirewallRulesClient = sqlFWClient
+	c.databasesClients.sqlFirewallRulesClient = sqlFWClient
 
 	sqlEPClient := sql.NewElasticPoolsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlEPClient.Client, auth)
-	c.sqlElasticPoolsClient = sqlEPClient
+	c.databasesClients.sqlElasticPoolsClient = sqlEPClient
 
 	MsSqlEPClient := MsSql.NewElasticPoolsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&MsSqlEPClient.Client, auth)
-	c.msSqlElasticPoolsClient = MsSqlEPClient
+	c.databasesClients.msSqlElasticPoolsClient = MsSqlEPClient
 
 	sqlSrvClient := sql.NewServersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlSrvClient.Client, auth)
-	c.sqlServersClient = sqlSrvClient
+	c.databasesClients.sqlServersClient = sqlSrvClient
 
 	sqlADClient := sql.NewServerAzureADAdministratorsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlADClient.Client, auth)
-	c.sqlServerAzureADAdministratorsClient = sqlADClient
+	c.databasesClients.sqlServerAzureADAdministratorsClient = sqlADClient
 
 	sqlVNRClient := sql.NewVirtualNetworkRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlVNRClient.Client, auth)
-	c.sqlVirtualNetworkRulesClient = sqlVNRClient
+	c.databasesClients.sqlVirtualNetworkRulesClient = sqlVNRClient
+}
+
+// dataFactory returns the clients for Data Factory, building them on first use
+func (c *ArmClient) dataFactory() *dataFactoryClients {
+	c.dataFactoryClients.once.Do(func() {
+		c.registerDataFactoryClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.dataFactoryClients
 }
 
 func (c *ArmClient) registerDataFactoryClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	dataFactoryClient := datafactory.NewFactoriesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&dataFactoryClient.Client, auth)
-	c.dataFactoryClient = dataFactoryClient
+	c.dataFactoryClients.dataFactoryClient = dataFactoryClient
 
 	dataFactoryDatasetClient := datafactory.NewDatasetsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&dataFactoryDatasetClient.Client, auth)
-	c.dataFactoryDatasetClient = dataFactoryDatasetClient
+	c.dataFactoryClients.dataFactoryDatasetClient = dataFactoryDatasetClient
 
 	dataFactoryLinkedServiceClient := datafactory.NewLinkedServicesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&dataFactoryLinkedServiceClient.Client, auth)
-	c.dataFactoryLinkedServiceClient = dataFactoryLinkedServiceClient
+	c.dataFactoryClients.dataFactoryLinkedServiceClient = dataFactoryLinkedServiceClient
 
 	dataFactoryPipelineClient := datafactory.NewPipelinesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&dataFactoryPipelineClient.Client, auth)
-	c.dataFactoryPipelineClient = dataFactoryPipelineClient
+	c.dataFactoryClients.dataFactoryPipelineClient = dataFactoryPipelineClient
+}
+
+// dataLake returns the clients for Data Lake, building them on first use
+func (c *ArmClient) dataLake() *dataLakeClients {
+	c.dataLakeClients.once.Do(func() {
+		c.registerDataLakeStoreClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.dataLakeClients
 }
 
 func (c *ArmClient) registerDataLakeStoreClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	storeAccountClient := storeAccount.NewAccountsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&storeAccountClient.Client, auth)
-	c.dataLakeStoreAccountClient = storeAccountClient
+	c.dataLakeClients.dataLakeStoreAccountClient = storeAccountClient
 
 	storeFirewallRulesClient := storeAccount.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&storeFirewallRulesClient.Client, auth)
-	c.dataLakeStoreFirewallRulesClient = storeFirewallRulesClient
+	c.dataLakeClients.dataLakeStoreFirewallRulesClient = storeFirewallRulesClient
 
 	analyticsAccountClient := analyticsAccount.NewAccountsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&analyticsAccountClient.Client, auth)
-	c.dataLakeAnalyticsAccountClient = analyticsAccountClient
+	c.dataLakeClients.dataLakeAnalyticsAccountClient = analyticsAccountClient
 
 	filesClient := filesystem.NewClient()
 	c.configureClient(&filesClient.Client, auth)
-	c.dataLakeStoreFilesClient = filesClient
+	c.dataLakeClients.dataLakeStoreFilesClient = filesClient
 
 	analyticsFirewallRulesClient := analyticsAccount.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&analyticsFirewallRulesClient.Client, auth)
-	c.dataLakeAnalyticsFirewallRulesClient = analyticsFirewallRulesClient
+	c.dataLakeClients.dataLakeAnalyticsFirewallRulesClient = analyticsFirewallRulesClient
+}
+
+// devices returns the clients for Devices, building them on first use
+func (c *ArmClient) devices() *devicesClients {
+	c.devicesClients.once.Do(func() {
+		c.registerDeviceClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.devicesClients
 }
 
 func (c *ArmClient) registerDeviceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	iotClient := devices.NewIotHubResourceClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&iotClient.Client, auth)
-	c.iothubResourceClient = iotClient
+	c.devicesClients.iothubResourceClient = iotClient
+}
+
+// devTest returns the clients for DevTest Labs, building them on first use
+func (c *ArmClient) devTest() *devTestClients {
+	c.devTestClients.once.Do(func() {
+		c.registerDevTestClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.devTestClients
 }
 
 func (c *ArmClient) registerDevTestClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	labsClient := dtl.NewLabsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&labsClient.Client, auth)
-	c.devTestLabsClient = labsClient
+	c.devTestClients.devTestLabsClient = labsClient
 
 	devTestPoliciesClient := dtl.NewPoliciesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&devTestPoliciesClient.Client, auth)
-	c.devTestPoliciesClient = devTestPoliciesClient
+	c.devTestClients.devTestPoliciesClient = devTestPoliciesClient
 
 	devTestVirtualMachinesClient := dtl.NewVirtualMachinesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&devTestVirtualMachinesClient.Client, auth)
-	c.devTestVirtualMachinesClient = devTestVirtualMachinesClient
+	c.devTestClients.devTestVirtualMachinesClient = devTestVirtualMachinesClient
 
 	devTestVirtualNetworksClient := dtl.NewVirtualNetworksClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&devTestVirtualNetworksClient.Client, auth)
-	c.devTestVirtualNetworksClient = devTestVirtualNetworksClient
+	c.devTestClients.devTestVirtualNetworksClient = devTestVirtualNetworksClient
+}
+
+// devSpace returns the clients for DevSpaces, building them on first use
+func (c *ArmClient) devSpace() *devSpaceClients {
+	c.devSpaceClients.once.Do(func() {
+		c.registerDevSpaceClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.devSpaceClients
 }
 
 func (c *ArmClient) registerDevSpaceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	controllersClient := devspaces.NewControllersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&controllersClient.Client, auth)
-	c.devSpaceControllerClient = controllersClient
+	c.devSpaceClients.devSpaceControllerClient = controllersClient
+}
+
+// dns returns the clients for DNS, building them on first use
+func (c *ArmClient) dns() *dnsClients {
+	c.dnsClients.once.Do(func() {
+		c.registerDNSClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.dnsClients
 }
 
 func (c *ArmClient) registerDNSClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	dn := dns.NewRecordSetsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&dn.Client, auth)
-	c.dnsClient = dn
+	c.dnsClients.dnsClient = dn
 
 	zo := dns.NewZonesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&zo.Client, auth)
-	c.zonesClient = zo
+	c.dnsClients.zonesClient = zo
+}
+
+// eventGrid returns the clients for EventGrid, building them on first use
+func (c *ArmClient) eventGrid() *eventGridClients {
+	c.eventGridClients.once.Do(func() {
+		c.registerEventGridClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.eventGridClients
 }
 
 func (c *ArmClient) registerEventGridClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	egtc := eventgrid.NewTopicsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&egtc.Client, auth)
-	c.eventGridTopicsClient = egtc
+	c.eventGridClients.eventGridTopicsClient = egtc
 
 	egdc := eventgrid.NewDomainsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&egdc.Client, auth)
-	c.eventGridDomainsClient = egdc
+	c.eventGridClients.eventGridDomainsClient = egdc
 
 	egesc := eventgrid.NewEventSubscriptionsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&egesc.Client, auth)
-	c.eventGridEventSubscriptionsClient = egesc
+	c.eventGridClients.eventGridEventSubscriptionsClient = egesc
+}
+
+// eventHub returns the clients for EventHub, building them on first use
+func (c *ArmClient) eventHub() *eventHubClients {
+	c.eventHubClients.once.Do(func() {
+		c.registerEventHubClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.eventHubClients
 }
 
 func (c *ArmClient) registerEventHubClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	ehc := eventhub.NewEventHubsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&ehc.Client, auth)
-	c.eventHubClient = ehc
+	c.eventHubClients.eventHubClient = ehc
 
 	chcgc := eventhub.NewConsumerGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&chcgc.Client, auth)
-	c.eventHubConsumerGroupClient = chcgc
+	c.eventHubClients.eventHubConsumerGroupClient = chcgc
 
 	ehnc := eventhub.NewNamespacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&ehnc.Client, auth)
-	c.eventHubNamespacesClient = ehnc
+	c.eventHubClients.eventHubNamespacesClient = ehnc
+}
+
+// hdinsight returns the clients for HDInsight, building them on first use
+func (c *ArmClient) hdinsight() *hdinsightClients {
+	c.hdinsightClients.once.Do(func() {
+		c.registerHDInsightsClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.hdinsightClients
 }
 
 func (c *ArmClient) registerHDInsightsClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	applicationsClient := hdinsight.NewApplicationsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&applicationsClient.Client, auth)
-	c.hdinsightApplicationsClient = applicationsClient
+	c.hdinsightClients.hdinsightApplicationsClient = applicationsClient
 
 	clustersClient := hdinsight.NewClustersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&clustersClient.Client, auth)
-	c.hdinsightClustersClient = clustersClient
+	c.hdinsightClients.hdinsightClustersClient = clustersClient
 
 	configurationsClient := hdinsight.NewConfigurationsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&configurationsClient.Client, auth)
-	c.hdinsightConfigurationsClient = configurationsClient
+	c.hdinsightClients.hdinsightConfigurationsClient = configurationsClient
+}
+
+// keyVault returns the clients for KeyVault, building them on first use
+func (c *ArmClient) keyVault() *keyVaultClients {
+	c.keyVaultClients.once.Do(func() {
+		c.registerKeyVaultClients(c.session.endpoint, c.subscriptionId, c.session.auth, c.session.keyVaultAuth)
+	})
+
+	return &c.keyVaultClients
 }
 
 func (c *ArmClient) registerKeyVaultClients(endpoint, subscriptionId string, auth autorest.Authorizer, keyVaultAuth autorest.Authorizer) {
 	keyVaultClient := keyvault.NewVaultsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&keyVaultClient.Client, auth)
-	c.keyVaultClient = keyVaultClient
+	c.keyVaultClients.keyVaultClient = keyVaultClient
 
 	keyVaultManagementClient := keyVault.New()
 	c.configureClient(&keyVaultManagementClient.Client, keyVaultAuth)
-	c.keyVaultManagementClient = keyVaultManagementClient
+	c.keyVaultClients.keyVaultManagementClient = keyVaultManagementClient
+}
+
+// logic returns the clients for Logic, building them on first use
+func (c *ArmClient) logic() *logicClients {
+	c.logicClients.once.Do(func() {
+		c.registerLogicClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.logicClients
 }
 
 func (c *ArmClient) registerLogicClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	workflowsClient := logic.NewWorkflowsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&workflowsClient.Client, auth)
-	c.logicWorkflowsClient = workflowsClient
+	c.logicClients.logicWorkflowsClient = workflowsClient
+}
+
+// monitor returns the clients for Monitor, building them on first use
+func (c *ArmClient) monitor() *monitorClients {
+	c.monitorClients.once.Do(func() {
+		c.registerMonitorClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.monitorClients
 }
 
 func (c *ArmClient) registerMonitorClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	agc := insights.NewActionGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&agc.Client, auth)
-	c.monitorActionGroupsClient = agc
+	c.monitorClients.monitorActionGroupsClient = agc
 
 	alac := insights.NewActivityLogAlertsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&alac.Client, auth)
-	c.monitorActivityLogAlertsClient = alac
+	c.monitorClients.monitorActivityLogAlertsClient = alac
 
 	arc := insights.NewAlertRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&arc.Client, auth)
-	c.monitorAlertRulesClient = arc
+	c.monitorClients.monitorAlertRulesClient = arc
 
 	monitorLogProfilesClient := insights.NewLogProfilesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&monitorLogProfilesClient.Client, auth)
-	c.monitorLogProfilesClient = monitorLogProfilesClient
+	c.monitorClients.monitorLogProfilesClient = monitorLogProfilesClient
 
 	mac := insights.NewMetricAlertsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&mac.Client, auth)
-	c.monitorMetricAlertsClient = mac
+	c.monitorClients.monitorMetricAlertsClient = mac
 
 	autoscaleSettingsClient := insights.NewAutoscaleSettingsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&autoscaleSettingsClient.Client, auth)
-	c.autoscaleSettingsClient = autoscaleSettingsClient
+	c.monitorClients.autoscaleSettingsClient = autoscaleSettingsClient
 
 	monitoringInsightsClient := insights.NewDiagnosticSettingsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&monitoringInsightsClient.Client, auth)
-	c.monitorDiagnosticSettingsClient = monitoringInsightsClient
+	c.monitorClients.monitorDiagnosticSettingsClient = monitoringInsightsClient
 
 	monitoringCategorySettingsClient := insights.NewDiagnosticSettingsCategoryClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&monitoringCategorySettingsClient.Client, auth)
-	c.monitorDiagnosticSettingsCategoryClient = monitoringCategorySettingsClient
+	c.monitorClients.monitorDiagnosticSettingsCategoryClient = monitoringCategorySettingsClient
+}
+
+// network returns the clients for Networking, building them on first use
+func (c *ArmClient) network() *networkClients {
+	c.networkClients.once.Do(func() {
+		c.registerNetworkingClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.networkClients
 }
 
 func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	applicationGatewaysClient := network.NewApplicationGatewaysClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&applicationGatewaysClient.Client, auth)
-	c.applicationGatewayClient = applicationGatewaysClient
+	c.networkClients.applicationGatewayClient = applicationGatewaysClient
 
 	appSecurityGroupsClient := network.NewApplicationSecurityGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&appSecurityGroupsClient.Client, auth)
-	c.applicationSecurityGroupsClient = appSecurityGroupsClient
+	c.networkClients.applicationSecurityGroupsClient = appSecurityGroupsClient
+
+	azureFirewallFqdnTagsClient := network.NewAzureFirewallFqdnTagsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&azureFirewallFqdnTagsClient.Client, auth)
+	c.networkClients.azureFirewallFqdnTagsClient = azureFirewallFqdnTagsClient
 
 	azureFirewallsClient := network.NewAzureFirewallsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&azureFirewallsClient.Client, auth)
-	c.azureFirewallsClient = azureFirewallsClient
+	c.networkClients.azureFirewallsClient = azureFirewallsClient
 
 	connectionMonitorsClient := network.NewConnectionMonitorsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&connectionMonitorsClient.Client, auth)
-	c.connectionMonitorsClient = connectionMonitorsClient
+	c.networkClients.connectionMonitorsClient = connectionMonitorsClient
+
+	ddosCustomPoliciesClient := network.NewDdosCustomPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&ddosCustomPoliciesClient.Client, auth)
+	c.networkClients.ddosCustomPoliciesClient = ddosCustomPoliciesClient
 
 	ddosProtectionPlanClient := network.NewDdosProtectionPlansClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&ddosProtectionPlanClient.Client, auth)
-	c.ddosProtectionPlanClient = ddosProtectionPlanClient
+	c.networkClients.ddosProtectionPlanClient = ddosProtectionPlanClient
 
 	expressRouteAuthsClient := network.NewExpressRouteCircuitAuthorizationsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&expressRouteAuthsClient.Client, auth)
-	c.expressRouteAuthsClient = expressRouteAuthsClient
+	c.networkClients.expressRouteAuthsClient = expressRouteAuthsClient
 
 	expressRouteCircuitsClient := network.NewExpressRouteCircuitsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&expressRouteCircuitsClient.Client, auth)
-	c.expressRouteCircuitClient = expressRouteCircuitsClient
+	c.networkClients.expressRouteCircuitClient = expressRouteCircuitsClient
 
 	expressRoutePeeringsClient := network.NewExpressRouteCircuitPeeringsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&expressRoutePeeringsClient.Client, auth)
-	c.expressRoutePeeringsClient = expressRoutePeeringsClient
+	c.networkClients.expressRoutePeeringsClient = expressRoutePeeringsClient
+
+	expressRouteConnectionsClient := network.NewExpressRouteConnectionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&expressRouteConnectionsClient.Client, auth)
+	c.networkClients.expressRouteConnectionsClient = expressRouteConnectionsClient
+
+	expressRouteGatewaysClient := network.NewExpressRouteGatewaysClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&expressRouteGatewaysClient.Client, auth)
+	c.networkClients.expressRouteGatewaysClient = expressRouteGatewaysClient
+
+	expressRoutePortsClient := network.NewExpressRoutePortsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&expressRoutePortsClient.Client, auth)
+	c.networkClients.expressRoutePortsClient = expressRoutePortsClient
+
+	hubVnetConnectionsClient := network.NewHubVirtualNetworkConnectionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&hubVnetConnectionsClient.Client, auth)
+	c.networkClients.hubVnetConnectionsClient = hubVnetConnectionsClient
 
 	interfacesClient := network.NewInterfacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&interfacesClient.Client, auth)
-	c.ifaceClient = interfacesClient
+	c.networkClients.ifaceClient = interfacesClient
+
+	interfaceTapConfigurationsClient := network.NewInterfaceTapConfigurationsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&interfaceTapConfigurationsClient.Client, auth)
+	c.networkClients.ifaceTapConfigurationsClient = interfaceTapConfigurationsClient
+
+	interfaceEndpointsClient := network.NewInterfaceEndpointsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&interfaceEndpointsClient.Client, auth)
+	c.networkClients.interfaceEndpointsClient = interfaceEndpointsClient
 
 	loadBalancersClient := network.NewLoadBalancersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&loadBalancersClient.Client, auth)
-	c.loadBalancerClient = loadBalancersClient
+	c.networkClients.loadBalancerClient = loadBalancersClient
 
 	localNetworkGatewaysClient := network.NewLocalNetworkGatewaysClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&localNetworkGatewaysClient.Client, auth)
-	c.localNetConnClient = localNetworkGatewaysClient
+	c.networkClients.localNetConnClient = localNetworkGatewaysClient
 
 	gatewaysClient := network.NewVirtualNetworkGatewaysClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&gatewaysClient.Client, auth)
-	c.vnetGatewayClient = gatewaysClient
+	c.networkClients.vnetGatewayClient = gatewaysClient
 
 	gatewayConnectionsClient := network.NewVirtualNetworkGatewayConnectionsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&gatewayConnectionsClient.Client, auth)
-	c.vnetGatewayConnectionsClient = gatewayConnectionsClient
+	c.networkClients.vnetGatewayConnectionsClient = gatewayConnectionsClient
 
 	networksClient := network.NewVirtualNetworksClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&networksClient.Client, auth)
-	c.vnetClient = networksClient
+	c.networkClients.vnetClient = networksClient
+
+	networkProfilesClient := network.NewProfilesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&networkProfilesClient.Client, auth)
+	c.networkClients.networkProfilesClient = networkProfilesClient
 
 	packetCapturesClient := network.NewPacketCapturesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&packetCapturesClient.Client, auth)
-	c.packetCapturesClient = packetCapturesClient
+	c.networkClients.packetCapturesClient = packetCapturesClient
+
+	p2sVpnGatewaysClient := network.NewP2sVpnGatewaysClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&p2sVpnGatewaysClient.Client, auth)
+	c.networkClients.p2sVpnGatewaysClient = p2sVpnGatewaysClient
+
+	p2sVpnServerConfigsClient := network.NewP2sVpnServerConfigurationsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&p2sVpnServerConfigsClient.Client, auth)
+	c.networkClients.p2sVpnServerConfigsClient = p2sVpnServerConfigsClient
 
 	peeringsClient := network.NewVirtualNetworkPeeringsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&peeringsClient.Client, auth)
-	c.vnetPeeringsClient = peeringsClient
+	c.networkClients.vnetPeeringsClient = peeringsClient
+
+	virtualNetworkTapsClient := network.NewVirtualNetworkTapsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualNetworkTapsClient.Client, auth)
+	c.networkClients.vnetTapsClient = virtualNetworkTapsClient
 
 	publicIPAddressesClient := network.NewPublicIPAddressesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&publicIPAddressesClient.Client, auth)
-	c.publicIPClient = publicIPAddressesClient
+	c.networkClients.publicIPClient = publicIPAddressesClient
 
 	publicIPPrefixesClient := network.NewPublicIPPrefixesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&publicIPPrefixesClient.Client, auth)
-	c.publicIPPrefixClient = publicIPPrefixesClient
+	c.networkClients.publicIPPrefixClient = publicIPPrefixesClient
+
+	routeFiltersClient := network.NewRouteFiltersClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&routeFiltersClient.Client, auth)
+	c.networkClients.routeFiltersClient = routeFiltersClient
+
+	routeFilterRulesClient := network.NewRouteFilterRulesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&routeFilterRulesClient.Client, auth)
+	c.networkClients.routeFilterRulesClient = routeFilterRulesClient
 
 	routesClient := network.NewRoutesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&routesClient.Client, auth)
-	c.routesClient = routesClient
+	c.networkClients.routesClient = routesClient
 
 	routeTablesClient := network.NewRouteTablesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&routeTablesClient.Client, auth)
-	c.routeTablesClient = routeTablesClient
+	c.networkClients.routeTablesClient = routeTablesClient
 
 	securityGroupsClient := network.NewSecurityGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&securityGroupsClient.Client, auth)
-	c.secGroupClient = securityGroupsClient
+	c.networkClients.secGroupClient = securityGroupsClient
 
 	securityRulesClient := network.NewSecurityRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&securityRulesClient.Client, auth)
-	c.secRuleClient = securityRulesClient
+	c.networkClients.secRuleClient = securityRulesClient
+
+	serviceEndpointPoliciesClient := network.NewServiceEndpointPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&serviceEndpointPoliciesClient.Client, auth)
+	c.networkClients.serviceEndpointPoliciesClient = serviceEndpointPoliciesClient
 
 	subnetsClient := network.NewSubnetsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&subnetsClient.Client, auth)
-	c.subnetClient = subnetsClient
+	c.networkClients.subnetClient = subnetsClient
 
 	userAssignedIdentitiesClient := msi.NewUserAssignedIdentitiesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&userAssignedIdentitiesClient.Client, auth)
-	c.userAssignedIdentitiesClient = userAssignedIdentitiesClient
+	c.networkClients.userAssignedIdentitiesClient = userAssignedIdentitiesClient
+
+	virtualHubsClient := network.NewVirtualHubsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualHubsClient.Client, auth)
+	c.networkClients.virtualHubsClient = virtualHubsClient
+
+	virtualWansClient := network.NewVirtualWansClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualWansClient.Client, auth)
+	c.networkClients.virtualWansClient = virtualWansClient
+
+	vpnConnectionsClient := network.NewVpnConnectionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vpnConnectionsClient.Client, auth)
+	c.networkClients.vpnConnectionsClient = vpnConnectionsClient
+
+	vpnGatewaysClient := network.NewVpnGatewaysClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vpnGatewaysClient.Client, auth)
+	c.networkClients.vpnGatewaysClient = vpnGatewaysClient
+
+	vpnSitesClient := network.NewVpnSitesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vpnSitesClient.Client, auth)
+	c.networkClients.vpnSitesClient = vpnSitesClient
+
+	vpnSitesConfigurationClient := network.NewVpnSitesConfigurationClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vpnSitesConfigurationClient.Client, auth)
+	c.networkClients.vpnSitesConfigurationClient = vpnSitesConfigurationClient
 
 	watchersClient := network.NewWatchersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&watchersClient.Client, auth)
-	c.watcherClient = watchersClient
+	c.networkClients.watcherClient = watchersClient
+
+	wafPoliciesClient := network.NewWebApplicationFirewallPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&wafPoliciesClient.Client, auth)
+	c.networkClients.wafPoliciesClient = wafPoliciesClient
+}
+
+// notificationHubs returns the clients for Notification Hubs, building them on first use
+func (c *ArmClient) notificationHubs() *notificationHubsClients {
+	c.notificationHubsClients.once.Do(func() {
+		c.registerNotificationHubsClient(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.notificationHubsClients
 }
 
 func (c *ArmClient) registerNotificationHubsClient(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	namespacesClient := notificationhubs.NewNamespacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&namespacesClient.Client, auth)
-	c.notificationNamespacesClient = namespacesClient
+	c.notificationHubsClients.notificationNamespacesClient = namespacesClient
 
 	notificationHubsClient := notificationhubs.NewClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&notificationHubsClient.Client, auth)
-	c.notificationHubsClient = notificationHubsClient
+	c.notificationHubsClients.notificationHubsClient = notificationHubsClient
+}
+
+// operationalInsights returns the clients for Log Analytics, building them on first use
+func (c *ArmClient) operationalInsights() *operationalInsightsClients {
+	c.operationalInsightsClients.once.Do(func() {
+		c.registerOperationalInsightsClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.operationalInsightsClients
 }
 
 func (c *ArmClient) registerOperationalInsightsClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	opwc := operationalinsights.NewWorkspacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&opwc.Client, auth)
-	c.workspacesClient = opwc
+	c.operationalInsightsClients.workspacesClient = opwc
 
 	solutionsClient := operationsmanagement.NewSolutionsClientWithBaseURI(endpoint, subscriptionId, "Microsoft.OperationsManagement", "solutions", "testing")
 	c.configureClient(&solutionsClient.Client, auth)
-	c.solutionsClient = solutionsClient
+	c.operationalInsightsClients.solutionsClient = solutionsClient
 
 	lsClient := operationalinsights.NewLinkedServicesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&lsClient.Client, auth)
-	c.linkedServicesClient = lsClient
+	c.operationalInsightsClients.linkedServicesClient = lsClient
+}
+
+// recoveryServices returns the clients for Recovery Services, building them on first use
+func (c *ArmClient) recoveryServices() *recoveryServicesClients {
+	c.recoveryServicesClients.once.Do(func() {
+		c.registerRecoveryServiceClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.recoveryServicesClients
 }
 
 func (c *ArmClient) registerRecoveryServiceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	vaultsClient := recoveryservices.NewVaultsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&vaultsClient.Client, auth)
-	c.recoveryServicesVaultsClient = vaultsClient
+	c.recoveryServicesClients.recoveryServicesVaultsClient = vaultsClient
 
 	protectedItemsClient := backup.NewProtectedItemsGroupClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&protectedItemsClient.Client, auth)
-	c.recoveryServicesProtectedItemsClient = protectedItemsClient
+	c.recoveryServicesClients.recoveryServicesProtectedItemsClient = protectedItemsClient
 
 	protectionPoliciesClient := backup.NewProtectionPoliciesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&protectionPoliciesClient.Client, auth)
-	c.recoveryServicesProtectionPoliciesClient = protectionPoliciesClient
+	c.recoveryServicesClients.recoveryServicesProtectionPoliciesClient = protectionPoliciesClient
+}
+
+// redis returns the clients for Redis, building them on first use
+func (c *ArmClient) redis() *redisClients {
+	c.redisClients.once.Do(func() {
+		c.registerRedisClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.redisClients
 }
 
 func (c *ArmClient) registerRedisClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	redisClient := redis.NewClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&redisClient.Client, auth)
-	c.redisClient = redisClient
+	c.redisClients.redisClient = redisClient
 
 	firewallRuleClient := redis.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&firewallRuleClient.Client, auth)
-	c.redisFirewallClient = firewallRuleClient
+	c.redisClients.redisFirewallClient = firewallRuleClient
 
 	patchSchedulesClient := redis.NewPatchSchedulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&patchSchedulesClient.Client, auth)
-	c.redisPatchSchedulesClient = patchSchedulesClient
+	c.redisClients.redisPatchSchedulesClient = patchSchedulesClient
+}
+
+// relay returns the clients for Relay, building them on first use
+func (c *ArmClient) relay() *relayClients {
+	c.relayClients.once.Do(func() {
+		c.registerRelayClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.relayClients
 }
 
 func (c *ArmClient) registerRelayClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	relayNamespacesClient := relay.NewNamespacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&relayNamespacesClient.Client, auth)
-	c.relayNamespacesClient = relayNamespacesClient
+	c.relayClients.relayNamespacesClient = relayNamespacesClient
+}
+
+// resources returns the clients for Resources, building them on first use
+func (c *ArmClient) resources() *resourcesClients {
+	c.resourcesClients.once.Do(func() {
+		c.registerResourcesClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.resourcesClients
 }
 
 func (c *ArmClient) registerResourcesClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	locksClient := locks.NewManagementLocksClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&locksClient.Client, auth)
-	c.managementLocksClient = locksClient
+	c.resourcesClients.managementLocksClient = locksClient
 
 	deploymentsClient := resources.NewDeploymentsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&deploymentsClient.Client, auth)
-	c.deploymentsClient = deploymentsClient
+	c.resourcesClients.deploymentsClient = deploymentsClient
 
 	resourcesClient := resources.NewClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&resourcesClient.Client, auth)
-	c.resourcesClient = resourcesClient
+	c.resourcesClients.resourcesClient = resourcesClient
 
 	resourceGroupsClient := resources.NewGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&resourceGroupsClient.Client, auth)
-	c.resourceGroupsClient = resourceGroupsClient
+	c.resourcesClients.resourceGroupsClient = resourceGroupsClient
 
 	subscriptionsClient := subscriptions.NewClientWithBaseURI(endpoint)
 	c.configureClient(&subscriptionsClient.Client, auth)
-	c.subscriptionsClient = subscriptionsClient
+	c.resourcesClients.subscriptionsClient = subscriptionsClient
 
 	// this has to come from the Profile since this is shared with Stack
 	providersClient := resourcesprofile.NewProvidersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&providersClient.Client, auth)
-	c.providersClient = providersClient
+	c.resourcesClients.providersClient = providersClient
+}
+
+// scheduler returns the clients for Scheduler, building them on first use
+func (c *ArmClient) scheduler() *schedulerClients {
+	c.schedulerClients.once.Do(func() {
+		c.registerSchedulerClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.schedulerClients
 }
 
 func (c *ArmClient) registerSchedulerClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	jobCollectionsClient := scheduler.NewJobCollectionsClientWithBaseURI(endpoint, subscriptionId) //nolint: megacheck
 	c.configureClient(&jobCollectionsClient.Client, auth)
-	c.schedulerJobCollectionsClient = jobCollectionsClient
+	c.schedulerClients.schedulerJobCollectionsClient = jobCollectionsClient
 
 	jobsClient := scheduler.NewJobsClientWithBaseURI(endpoint, subscriptionId) //nolint: megacheck
 	c.configureClient(&jobsClient.Client, auth)
-	c.schedulerJobsClient = jobsClient
+	c.schedulerClients.schedulerJobsClient = jobsClient
+}
+
+// search returns the clients for Search, building them on first use
+func (c *ArmClient) search() *searchClients {
+	c.searchClients.once.Do(func() {
+		c.registerSearchClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.searchClients
 }
 
 func (c *ArmClient) registerSearchClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	searchClient := search.NewServicesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&searchClient.Client, auth)
-	c.searchServicesClient = searchClient
+	c.searchClients.searchServicesClient = searchClient
 
 	searchAdminKeysClient := search.NewAdminKeysClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&searchAdminKeysClient.Client, auth)
-	c.searchAdminKeysClient = searchAdminKeysClient
+	c.searchClients.searchAdminKeysClient = searchAdminKeysClient
+}
+
+// securityCenter returns the clients for Security Center, building them on first use
+func (c *ArmClient) securityCenter() *securityCenterClients {
+	c.securityCenterClients.once.Do(func() {
+		c.registerSecurityCenterClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.securityCenterClients
 }
 
 func (c *ArmClient) registerSecurityCenterClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -1282,129 +2087,210 @@ func (c *ArmClient) registerSecurityCenterClients(endpoint, subscriptionId strin
 
 	securityCenterPricingClient := security.NewPricingsClientWithBaseURI(endpoint, subscriptionId, ascLocation)
 	c.configureClient(&securityCenterPricingClient.Client, auth)
-	c.securityCenterPricingClient = securityCenterPricingClient
+	c.securityCenterClients.securityCenterPricingClient = securityCenterPricingClient
 
 	securityCenterContactsClient := security.NewContactsClientWithBaseURI(endpoint, subscriptionId, ascLocation)
 	c.configureClient(&securityCenterContactsClient.Client, auth)
-	c.securityCenterContactsClient = securityCenterContactsClient
+	c.securityCenterClients.securityCenterContactsClient = securityCenterContactsClient
 
 	securityCenterWorkspaceClient := security.NewWorkspaceSettingsClientWithBaseURI(endpoint, subscriptionId, ascLocation)
 	c.configureClient(&securityCenterWorkspaceClient.Client, auth)
-	c.securityCenterWorkspaceClient = securityCenterWorkspaceClient
+	c.securityCenterClients.securityCenterWorkspaceClient = securityCenterWorkspaceClient
+}
+
+// serviceBus returns the clients for ServiceBus, building them on first use
+func (c *ArmClient) serviceBus() *serviceBusClients {
+	c.serviceBusClients.once.Do(func() {
+		c.registerServiceBusClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.serviceBusClients
 }
 
 func (c *ArmClient) registerServiceBusClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	queuesClient := servicebus.NewQueuesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&queuesClient.Client, auth)
-	c.serviceBusQueuesClient = queuesClient
+	c.serviceBusClients.serviceBusQueuesClient = queuesClient
 
 	namespacesClient := servicebus.NewNamespacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&namespacesClient.Client, auth)
-	c.serviceBusNamespacesClient = namespacesClient
+	c.serviceBusClients.serviceBusNamespacesClient = namespacesClient
 
 	topicsClient := servicebus.NewTopicsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&topicsClient.Client, auth)
-	c.serviceBusTopicsClient = topicsClient
+	c.serviceBusClients.serviceBusTopicsClient = topicsClient
 
 	subscriptionsClient := servicebus.NewSubscriptionsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&subscriptionsClient.Client, auth)
-	c.serviceBusSubscriptionsClient = subscriptionsClient
+	c.serviceBusClients.serviceBusSubscriptionsClient = subscriptionsClient
 
 	subscriptionRulesClient := servicebus.NewRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&subscriptionRulesClient.Client, auth)
-	c.serviceBusSubscriptionRulesClient = subscriptionRulesClient
+	c.serviceBusClients.serviceBusSubscriptionRulesClient = subscriptionRulesClient
+}
+
+// serviceFabric returns the clients for Service Fabric, building them on first use
+func (c *ArmClient) serviceFabric() *serviceFabricClients {
+	c.serviceFabricClients.once.Do(func() {
+		c.registerServiceFabricClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.serviceFabricClients
 }
 
 func (c *ArmClient) registerServiceFabricClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	clustersClient := servicefabric.NewClustersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&clustersClient.Client, auth)
-	c.serviceFabricClustersClient = clustersClient
+	c.serviceFabricClients.serviceFabricClustersClient = clustersClient
+}
+
+// signalR returns the clients for SignalR, building them on first use
+func (c *ArmClient) signalR() *signalRClients {
+	c.signalRClients.once.Do(func() {
+		c.registerSignalRClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.signalRClients
 }
 
 func (c *ArmClient) registerSignalRClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	sc := signalr.NewClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sc.Client, auth)
-	c.signalRClient = sc
+	c.signalRClients.signalRClient = sc
+}
+
+// storage returns the clients for Storage, building them on first use
+func (c *ArmClient) storage() *storageClients {
+	c.storageClients.once.Do(func() {
+		c.registerStorageClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.storageClients
 }
 
 func (c *ArmClient) registerStorageClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	accountsClient := storage.NewAccountsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&accountsClient.Client, auth)
-	c.storageServiceClient = accountsClient
+	c.storageClients.storageServiceClient = accountsClient
 
 	usageClient := storage.NewUsageClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&usageClient.Client, auth)
-	c.storageUsageClient = usageClient
+	c.storageClients.storageUsageClient = usageClient
+}
+
+// streamAnalytics returns the clients for Stream Analytics, building them on first use
+func (c *ArmClient) streamAnalytics() *streamAnalyticsClients {
+	c.streamAnalyticsClients.once.Do(func() {
+		c.registerStreamAnalyticsClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.streamAnalyticsClients
 }
 
 func (c *ArmClient) registerStreamAnalyticsClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	functionsClient := streamanalytics.NewFunctionsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&functionsClient.Client, auth)
-	c.streamAnalyticsFunctionsClient = functionsClient
+	c.streamAnalyticsClients.streamAnalyticsFunctionsClient = functionsClient
 
 	jobsClient := streamanalytics.NewStreamingJobsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&jobsClient.Client, auth)
-	c.streamAnalyticsJobsClient = jobsClient
+	c.streamAnalyticsClients.streamAnalyticsJobsClient = jobsClient
 
 	inputsClient := streamanalytics.NewInputsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&inputsClient.Client, auth)
-	c.streamAnalyticsInputsClient = inputsClient
+	c.streamAnalyticsClients.streamAnalyticsInputsClient = inputsClient
 
 	outputsClient := streamanalytics.NewOutputsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&outputsClient.Client, auth)
-	c.streamAnalyticsOutputsClient = outputsClient
+	c.streamAnalyticsClients.streamAnalyticsOutputsClient = outputsClient
 
 	transformationsClient := streamanalytics.NewTransformationsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&transformationsClient.Client, auth)
-	c.streamAnalyticsTransformationsClient = transformationsClient
+	c.streamAnalyticsClients.streamAnalyticsTransformationsClient = transformationsClient
+}
+
+// trafficManager returns the clients for Traffic Manager, building them on first use
+func (c *ArmClient) trafficManager() *trafficManagerClients {
+	c.trafficManagerClients.once.Do(func() {
+		c.registerTrafficManagerClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.trafficManagerClients
 }
 
 func (c *ArmClient) registerTrafficManagerClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	endpointsClient := trafficmanager.NewEndpointsClientWithBaseURI(endpoint, c.subscriptionId)
 	c.configureClient(&endpointsClient.Client, auth)
-	c.trafficManagerEndpointsClient = endpointsClient
+	c.trafficManagerClients.trafficManagerEndpointsClient = endpointsClient
 
 	geographicalHierarchiesClient := trafficmanager.NewGeographicHierarchiesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&geographicalHierarchiesClient.Client, auth)
-	c.trafficManagerGeographialHierarchiesClient = geographicalHierarchiesClient
+	c.trafficManagerClients.trafficManagerGeographialHierarchiesClient = geographicalHierarchiesClient
 
 	profilesClient := trafficmanager.NewProfilesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&profilesClient.Client, auth)
-	c.trafficManagerProfilesClient = profilesClient
+	c.trafficManagerClients.trafficManagerProfilesClient = profilesClient
+}
+
+// web returns the clients for Web, building them on first use
+func (c *ArmClient) web() *webClients {
+	c.webClients.once.Do(func() {
+		c.registerWebClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.webClients
 }
 
 func (c *ArmClient) registerWebClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	appServicePlansClient := web.NewAppServicePlansClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&appServicePlansClient.Client, auth)
-	c.appServicePlansClient = appServicePlansClient
+	c.webClients.appServicePlansClient = appServicePlansClient
 
 	appsClient := web.NewAppsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&appsClient.Client, auth)
-	c.appServicesClient = appsClient
+	c.webClients.appServicesClient = appsClient
+}
+
+// policy returns the clients for Policy, building them on first use
+func (c *ArmClient) policy() *policyClients {
+	c.policyClients.once.Do(func() {
+		c.registerPolicyClients(c.session.endpoint, c.subscriptionId, c.session.auth)
+	})
+
+	return &c.policyClients
 }
 
 func (c *ArmClient) registerPolicyClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	policyAssignmentsClient := policy.NewAssignmentsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&policyAssignmentsClient.Client, auth)
-	c.policyAssignmentsClient = policyAssignmentsClient
+	c.policyClients.policyAssignmentsClient = policyAssignmentsClient
 
 	policyDefinitionsClient := policy.NewDefinitionsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&policyDefinitionsClient.Client, auth)
-	c.policyDefinitionsClient = policyDefinitionsClient
+	c.policyClients.policyDefinitionsClient = policyDefinitionsClient
 
 	policySetDefinitionsClient := policy.NewSetDefinitionsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&policySetDefinitionsClient.Client, auth)
-	c.policySetDefinitionsClient = policySetDefinitionsClient
+	c.policyClients.policySetDefinitionsClient = policySetDefinitionsClient
+}
+
+// managementGroups returns the clients for Management Groups, building them on first use
+func (c *ArmClient) managementGroups() *managementGroupsClients {
+	c.managementGroupsClients.once.Do(func() {
+		c.registerManagementGroupClients(c.session.endpoint, c.session.auth)
+	})
+
+	return &c.managementGroupsClients
 }
 
 func (c *ArmClient) registerManagementGroupClients(endpoint string, auth autorest.Authorizer) {
 	managementGroupsClient := managementgroups.NewClientWithBaseURI(endpoint)
 	c.configureClient(&managementGroupsClient.Client, auth)
-	c.managementGroupsClient = managementGroupsClient
+	c.managementGroupsClients.managementGroupsClient = managementGroupsClient
 
 	managementGroupsSubscriptionClient := managementgroups.NewSubscriptionsClientWithBaseURI(endpoint)
 	c.configureClient(&managementGroupsSubscriptionClient.Client, auth)
-	c.managementGroupsSubscriptionClient = managementGroupsSubscriptionClient
+	c.managementGroupsClients.managementGroupsSubscriptionClient = managementGroupsSubscriptionClient
 }
 
 var (
@@ -1426,7 +2312,7 @@ func (c *ArmClient) getKeyForStorageAccount(ctx context.Context, resourceGroupNa
 	defer storageKeyCacheMu.Unlock()
 	key, ok = storageKeyCache[cacheIndex]
 	if !ok {
-		accountKeys, err := c.storageServiceClient.ListKeys(ctx, resourceGroupName, storageAccountName)
+		accountKeys, err := c.storage().storageServiceClient.ListKeys(ctx, resourceGroupName, storageAccountName)
 		if utils.ResponseWasNotFound(accountKeys.Response) {
 			return "", false, nil
 		}
@@ -1,6 +1,11 @@
 package azurerm
 
-import "testing"
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+)
 
 func TestClientRequestID(t *testing.T) {
 	first := clientRequestID()
@@ -14,3 +19,132 @@ func TestClientRequestID(t *testing.T) {
 		t.Fatal("subsequent request ID not the same as the first")
 	}
 }
+
+func TestClientForSubscription(t *testing.T) {
+	client := &ArmClient{
+		subscriptionId: "00000000-0000-0000-0000-000000000000",
+		session: &armSession{
+			endpoint:            "https://management.azure.com/",
+			subscriptionClients: make(map[string]*subscriptionClient),
+		},
+	}
+
+	same, err := client.clientForSubscription("00000000-0000-0000-0000-000000000000")
+	if err != nil {
+		t.Fatalf("Error retrieving the client for the default Subscription: %+v", err)
+	}
+	if same != client {
+		t.Fatalf("Expected the client for the default Subscription to be re-used")
+	}
+
+	subscriptionId := "aaaaaaaa-1111-1111-1111-111111111111"
+	clients := make([]*ArmClient, 10)
+	var wg sync.WaitGroup
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			clients[i], _ = client.clientForSubscription(subscriptionId)
+		}(i)
+	}
+	wg.Wait()
+
+	for i, other := range clients {
+		if other == nil || other != clients[0] {
+			t.Fatalf("Expected the client for Subscription %q to be built once but got a different client for call %d", subscriptionId, i)
+		}
+	}
+
+	if clients[0].subscriptionId != subscriptionId {
+		t.Fatalf("Expected the client to be for Subscription %q but got %q", subscriptionId, clients[0].subscriptionId)
+	}
+
+	upper, _ := client.clientForSubscription("AAAAAAAA-1111-1111-1111-111111111111")
+	if upper != clients[0] {
+		t.Fatalf("Expected the Subscription ID to be compared case-insensitively")
+	}
+}
+
+func TestClientForSubscriptionCopiesProviderSettings(t *testing.T) {
+	client := &ArmClient{
+		subscriptionId:           "00000000-0000-0000-0000-000000000000",
+		partnerId:                "11111111-1111-1111-1111-111111111111",
+		skipProviderRegistration: true,
+		defaultTags: map[string]interface{}{
+			"environment": "Production",
+		},
+		ignoreTagPrefixes: []string{"hidden-"},
+		session: &armSession{
+			endpoint:            "https://management.azure.com/",
+			subscriptionClients: make(map[string]*subscriptionClient),
+		},
+		StopContext: context.Background(),
+	}
+
+	other, err := client.clientForSubscription("aaaaaaaa-1111-1111-1111-111111111111")
+	if err != nil {
+		t.Fatalf("Error retrieving the client for the other Subscription: %+v", err)
+	}
+
+	if other.partnerId != client.partnerId || other.skipProviderRegistration != client.skipProviderRegistration {
+		t.Fatalf("Expected the Partner ID and Skip Provider Registration to be copied from the provider")
+	}
+	if !reflect.DeepEqual(other.defaultTags, client.defaultTags) {
+		t.Fatalf("Expected the Default Tags %+v but got %+v", client.defaultTags, other.defaultTags)
+	}
+	if !reflect.DeepEqual(other.ignoreTagPrefixes, client.ignoreTagPrefixes) {
+		t.Fatalf("Expected the Ignored Tag Prefixes %+v but got %+v", client.ignoreTagPrefixes, other.ignoreTagPrefixes)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client.setStopContext(ctx)
+	if other.StopContext != ctx {
+		t.Fatalf("Expected the StopContext of the client for the other Subscription to be replaced")
+	}
+}
+
+func TestClientForResourceWithoutSubscriptionIdField(t *testing.T) {
+	client := &ArmClient{
+		subscriptionId: "00000000-0000-0000-0000-000000000000",
+		session: &armSession{
+			subscriptionClients: make(map[string]*subscriptionClient),
+		},
+	}
+
+	d := resourceArmResourceGroup().TestResourceData()
+	actual, err := client.clientForResource(d)
+	if err != nil {
+		t.Fatalf("Error retrieving the client for the resource: %+v", err)
+	}
+	if actual != client {
+		t.Fatalf("Expected the client for the default Subscription to be used")
+	}
+}
+
+func TestClientsAreBuiltOnFirstUse(t *testing.T) {
+	client := &ArmClient{
+		subscriptionId: "00000000-0000-0000-0000-000000000000",
+		session: &armSession{
+			endpoint:            "https://management.azure.com/",
+			subscriptionClients: make(map[string]*subscriptionClient),
+		},
+	}
+
+	if client.computeClients.vmClient.BaseURI != "" {
+		t.Fatalf("Expected the Compute clients not to be built until they're used")
+	}
+
+	vmClient := client.compute().vmClient
+	if vmClient.BaseURI != "https://management.azure.com/" || vmClient.SubscriptionID != client.subscriptionId {
+		t.Fatalf("Expected the Compute clients to be built for the Subscription but got %q / %q", vmClient.BaseURI, vmClient.SubscriptionID)
+	}
+
+	if client.compute() != client.compute() {
+		t.Fatalf("Expected the Compute clients to be built once")
+	}
+
+	if client.networkClients.vnetClient.BaseURI != "" {
+		t.Fatalf("Expected the Network clients not to be built when only the Compute clients are used")
+	}
+}
@@ -49,7 +49,7 @@ func dataSourceArmBatchAccount() *schema.Resource {
 }
 
 func dataSourceArmBatchAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).batchAccountClient
+	client := meta.(*ArmClient).batch().batchAccountClient
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	name := d.Get("name").(string)
@@ -81,7 +81,7 @@ As such this Data Source will be removed in v2.0 of the AzureRM Provider.
 }
 
 func dataSourceArmBuiltInRoleDefinitionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).roleDefinitionsClient
+	client := meta.(*ArmClient).authentication().roleDefinitionsClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
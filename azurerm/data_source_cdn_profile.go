@@ -32,7 +32,7 @@ func dataSourceArmCdnProfile() *schema.Resource {
 }
 
 func dataSourceArmCdnProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).cdnProfilesClient
+	client := meta.(*ArmClient).cdn().cdnProfilesClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
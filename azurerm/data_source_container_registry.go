@@ -59,7 +59,7 @@ func dataSourceArmContainerRegistry() *schema.Resource {
 }
 
 func dataSourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).containerRegistryClient
+	client := meta.(*ArmClient).containerRegistry().containerRegistryClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
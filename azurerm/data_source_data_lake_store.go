@@ -53,7 +53,7 @@ func dataSourceArmDataLakeStoreAccount() *schema.Resource {
 }
 
 func dataSourceArmDateLakeStoreAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).dataLakeStoreAccountClient
+	client := meta.(*ArmClient).dataLake().dataLakeStoreAccountClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
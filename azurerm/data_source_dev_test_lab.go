@@ -64,7 +64,7 @@ func dataSourceArmDevTestLab() *schema.Resource {
 }
 
 func dataSourceArmDevTestLabRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).devTestLabsClient
+	client := meta.(*ArmClient).devTest().devTestLabsClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
@@ -7,6 +7,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -26,6 +27,8 @@ func dataSourceArmDnsZone() *schema.Resource {
 				Computed: true,
 			},
 
+			"subscription_id": azure.SchemaSubscriptionIdOverrideForDataSource(),
+
 			"number_of_record_sets": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -66,16 +69,17 @@ func dataSourceArmDnsZone() *schema.Resource {
 }
 
 func dataSourceArmDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).zonesClient
+	armClient, err := meta.(*ArmClient).clientForSubscription(d.Get("subscription_id").(string))
+	if err != nil {
+		return err
+	}
+	client := armClient.dns().zonesClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	var (
-		resp dns.Zone
-		err  error
-	)
+	var resp dns.Zone
 	if resourceGroup != "" {
 		resp, err = client.Get(ctx, resourceGroup, name)
 		if err != nil {
@@ -85,7 +89,7 @@ func dataSourceArmDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("Error reading DNS Zone %q (Resource Group %q): %+v", name, resourceGroup, err)
 		}
 	} else {
-		rgClient := meta.(*ArmClient).resourceGroupsClient
+		rgClient := armClient.resources().resourceGroupsClient
 
 		resp, resourceGroup, err = findZone(client, rgClient, ctx, name)
 		if err != nil {
@@ -100,6 +104,7 @@ func dataSourceArmDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(*resp.ID)
 	d.Set("name", name)
 	d.Set("resource_group_name", resourceGroup)
+	d.Set("subscription_id", armClient.subscriptionId)
 
 	if props := resp.ZoneProperties; props != nil {
 		d.Set("number_of_record_sets", props.NumberOfRecordSets)
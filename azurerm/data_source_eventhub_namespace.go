@@ -77,7 +77,7 @@ func dataSourceEventHubNamespace() *schema.Resource {
 }
 
 func dataSourceEventHubNamespaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).eventHubNamespacesClient
+	client := meta.(*ArmClient).eventHub().eventHubNamespacesClient
 	ctx := meta.(*ArmClient).StopContext
 
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -114,7 +114,7 @@ func dataSourceArmExpressRouteCircuit() *schema.Resource {
 
 func dataSourceArmExpressRouteCircuitRead(d *schema.ResourceData, meta interface{}) error {
 	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).expressRouteCircuitClient
+	client := meta.(*ArmClient).network().expressRouteCircuitClient
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -0,0 +1,57 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmFirewallFqdnTags() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmFirewallFqdnTagsRead,
+
+		Schema: map[string]*schema.Schema{
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmFirewallFqdnTagsRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	client := armClient.network().azureFirewallFqdnTagsClient
+	ctx := armClient.StopContext
+
+	results, err := client.ListAllComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("Error listing Firewall FQDN Tags: %+v", err)
+	}
+
+	names := make([]string, 0)
+	for results.NotDone() {
+		tag := results.Value()
+
+		// the FQDN Tag Name is what's referenced from an Application Rule, which isn't always the same as the Name
+		if props := tag.AzureFirewallFqdnTagPropertiesFormat; props != nil && props.FqdnTagName != nil {
+			names = append(names, *props.FqdnTagName)
+		} else if tag.Name != nil {
+			names = append(names, *tag.Name)
+		}
+
+		if err = results.Next(); err != nil {
+			return fmt.Errorf("Error going to next Firewall FQDN Tags value: %+v", err)
+		}
+	}
+
+	d.SetId("firewallFqdnTags-" + armClient.subscriptionId)
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("Error setting `names`: %+v", err)
+	}
+
+	return nil
+}
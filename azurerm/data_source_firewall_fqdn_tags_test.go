@@ -0,0 +1,25 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMFirewallFqdnTags_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_firewall_fqdn_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "azurerm_firewall_fqdn_tags" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "names.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "names.0"),
+				),
+			},
+		},
+	})
+}
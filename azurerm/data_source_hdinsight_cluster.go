@@ -84,8 +84,8 @@ func dataSourceArmHDInsightSparkCluster() *schema.Resource {
 }
 
 func dataSourceArmHDInsightClusterRead(d *schema.ResourceData, meta interface{}) error {
-	clustersClient := meta.(*ArmClient).hdinsightClustersClient
-	configurationsClient := meta.(*ArmClient).hdinsightConfigurationsClient
+	clustersClient := meta.(*ArmClient).hdinsight().hdinsightClustersClient
+	configurationsClient := meta.(*ArmClient).hdinsight().hdinsightConfigurationsClient
 	ctx := meta.(*ArmClient).StopContext
 
 	resourceGroup := d.Get("resource_group_name").(string)
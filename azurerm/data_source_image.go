@@ -113,7 +113,7 @@ func dataSourceArmImage() *schema.Resource {
 }
 
 func dataSourceArmImageRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).imageClient
+	client := meta.(*ArmClient).compute().imageClient
 	ctx := meta.(*ArmClient).StopContext
 
 	resGroup := d.Get("resource_group_name").(string)
@@ -148,7 +148,7 @@ func dataSourceArmKeyVault() *schema.Resource {
 }
 
 func dataSourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).keyVaultClient
+	client := meta.(*ArmClient).keyVault().keyVaultClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -25,7 +26,7 @@ func dataSourceArmKeyVaultKey() *schema.Resource {
 				Optional:      true, //todo required in 2.0
 				Computed:      true, //todo removed in 2.0
 				ForceNew:      true,
-				ValidateFunc:  azure.ValidateResourceID,
+				ValidateFunc:  ids.ValidateKeyVaultID,
 				ConflictsWith: []string{"vault_uri"},
 			},
 
@@ -79,8 +80,8 @@ func dataSourceArmKeyVaultKey() *schema.Resource {
 }
 
 func dataSourceArmKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error {
-	vaultClient := meta.(*ArmClient).keyVaultClient
-	client := meta.(*ArmClient).keyVaultManagementClient
+	vaultClient := meta.(*ArmClient).keyVault().keyVaultClient
+	client := meta.(*ArmClient).keyVault().keyVaultManagementClient
 	ctx := meta.(*ArmClient).StopContext
 
 	keyVaultBaseUri := d.Get("vault_uri").(string)
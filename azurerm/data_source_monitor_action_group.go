@@ -89,7 +89,7 @@ func dataSourceArmMonitorActionGroup() *schema.Resource {
 }
 
 func dataSourceArmMonitorActionGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).monitorActionGroupsClient
+	client := meta.(*ArmClient).monitor().monitorActionGroupsClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
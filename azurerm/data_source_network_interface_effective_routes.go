@@ -0,0 +1,140 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+func dataSourceArmNetworkInterfaceEffectiveRoutes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmNetworkInterfaceEffectiveRoutesRead,
+
+		Schema: map[string]*schema.Schema{
+			"network_interface_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"route": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"address_prefixes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"next_hop_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"next_hop_ip_addresses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmNetworkInterfaceEffectiveRoutesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("network_interface_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	future, err := client.GetEffectiveRouteTable(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error: Network Interface %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+		return fmt.Errorf("Error retrieving Effective Routes for Network Interface %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Effective Routes for Network Interface %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	result, err := future.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Effective Routes for Network Interface %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	// the effective routes are computed when they're requested, so there's no ID for these
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("route", flattenArmNetworkInterfaceEffectiveRoutes(result.Value)); err != nil {
+		return fmt.Errorf("Error setting `route`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenArmNetworkInterfaceEffectiveRoutes(input *[]network.EffectiveRoute) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, route := range *input {
+		name := ""
+		if route.Name != nil {
+			name = *route.Name
+		}
+
+		addressPrefixes := make([]interface{}, 0)
+		if route.AddressPrefix != nil {
+			for _, v := range *route.AddressPrefix {
+				addressPrefixes = append(addressPrefixes, v)
+			}
+		}
+
+		nextHopIPAddresses := make([]interface{}, 0)
+		if route.NextHopIPAddress != nil {
+			for _, v := range *route.NextHopIPAddress {
+				nextHopIPAddresses = append(nextHopIPAddresses, v)
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                  name,
+			"source":                string(route.Source),
+			"state":                 string(route.State),
+			"address_prefixes":      addressPrefixes,
+			"next_hop_type":         string(route.NextHopType),
+			"next_hop_ip_addresses": nextHopIPAddresses,
+		})
+	}
+
+	return results
+}
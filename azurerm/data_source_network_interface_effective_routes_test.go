@@ -0,0 +1,138 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMNetworkInterfaceEffectiveRoutes_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_network_interface_effective_routes.test"
+	ri := testAccRandTimeInt(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMNetworkInterfaceEffectiveRoutes_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "route.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "route.0.source", "Default"),
+					resource.TestCheckResourceAttr(dataSourceName, "route.0.state", "Active"),
+					resource.TestCheckResourceAttr(dataSourceName, "route.0.address_prefixes.0", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(dataSourceName, "route.0.next_hop_type", "VnetLocal"),
+				),
+			},
+		},
+	})
+}
+
+// testAccAzureRMNetworkInterfaceEffective_template provisions a running Virtual Machine, since the effective
+// routes and security rules are only available for a Network Interface attached to a running Virtual Machine
+func testAccAzureRMNetworkInterfaceEffective_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestnsg-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  security_rule {
+    name                       = "allow-ssh"
+    priority                   = 100
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "22"
+    source_address_prefix      = "10.0.0.0/16"
+    destination_address_prefix = "*"
+  }
+}
+
+resource "azurerm_subnet_network_security_group_association" "test" {
+  subnet_id                 = "${azurerm_subnet.test.id}"
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctni-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_virtual_machine" "test" {
+  name                  = "acctvm-%d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  network_interface_ids = ["${azurerm_network_interface.test.id}"]
+  vm_size               = "Standard_F2"
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "osdisk"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  os_profile {
+    computer_name  = "hostname%d"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = false
+  }
+
+  depends_on = ["azurerm_subnet_network_security_group_association.test"]
+}
+`, rInt, location, rInt, rInt, rInt, rInt, rInt)
+}
+
+func testAccDataSourceAzureRMNetworkInterfaceEffectiveRoutes_basic(rInt int, location string) string {
+	template := testAccAzureRMNetworkInterfaceEffective_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_interface_effective_routes" "test" {
+  network_interface_name = "${azurerm_network_interface.test.name}"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+
+  depends_on = ["azurerm_virtual_machine.test"]
+}
+`, template)
+}
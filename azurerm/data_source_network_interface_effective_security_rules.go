@@ -0,0 +1,244 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+func dataSourceArmNetworkInterfaceEffectiveSecurityRules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmNetworkInterfaceEffectiveSecurityRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"network_interface_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"network_security_group": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"network_interface_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"security_rule": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"priority": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+
+									"direction": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"access": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"protocol": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"source_port_ranges": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"destination_port_ranges": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"source_address_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"destination_address_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"expanded_source_address_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"expanded_destination_address_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmNetworkInterfaceEffectiveSecurityRulesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("network_interface_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	future, err := client.ListEffectiveNetworkSecurityGroups(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error: Network Interface %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+		return fmt.Errorf("Error retrieving Effective Security Rules for Network Interface %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Effective Security Rules for Network Interface %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	result, err := future.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Effective Security Rules for Network Interface %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	// the effective security rules are computed when they're requested, so there's no ID for these
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("network_security_group", flattenArmNetworkInterfaceEffectiveNetworkSecurityGroups(result.Value)); err != nil {
+		return fmt.Errorf("Error setting `network_security_group`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenArmNetworkInterfaceEffectiveNetworkSecurityGroups(input *[]network.EffectiveNetworkSecurityGroup) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, group := range *input {
+		id := ""
+		if group.NetworkSecurityGroup != nil && group.NetworkSecurityGroup.ID != nil {
+			id = *group.NetworkSecurityGroup.ID
+		}
+
+		subnetId := ""
+		networkInterfaceId := ""
+		if association := group.Association; association != nil {
+			if association.Subnet != nil && association.Subnet.ID != nil {
+				subnetId = *association.Subnet.ID
+			}
+			if association.NetworkInterface != nil && association.NetworkInterface.ID != nil {
+				networkInterfaceId = *association.NetworkInterface.ID
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                   id,
+			"subnet_id":            subnetId,
+			"network_interface_id": networkInterfaceId,
+			"security_rule":        flattenArmNetworkInterfaceEffectiveSecurityRules(group.EffectiveSecurityRules),
+		})
+	}
+
+	return results
+}
+
+func flattenArmNetworkInterfaceEffectiveSecurityRules(input *[]network.EffectiveNetworkSecurityRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, rule := range *input {
+		name := ""
+		if rule.Name != nil {
+			name = *rule.Name
+		}
+
+		priority := 0
+		if rule.Priority != nil {
+			priority = int(*rule.Priority)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                                  name,
+			"priority":                              priority,
+			"direction":                             string(rule.Direction),
+			"access":                                string(rule.Access),
+			"protocol":                              string(rule.Protocol),
+			"source_port_ranges":                    flattenArmEffectiveSecurityRuleValues(rule.SourcePortRange, rule.SourcePortRanges),
+			"destination_port_ranges":               flattenArmEffectiveSecurityRuleValues(rule.DestinationPortRange, rule.DestinationPortRanges),
+			"source_address_prefixes":               flattenArmEffectiveSecurityRuleValues(rule.SourceAddressPrefix, rule.SourceAddressPrefixes),
+			"destination_address_prefixes":          flattenArmEffectiveSecurityRuleValues(rule.DestinationAddressPrefix, rule.DestinationAddressPrefixes),
+			"expanded_source_address_prefixes":      flattenArmEffectiveSecurityRuleValues(nil, rule.ExpandedSourceAddressPrefix),
+			"expanded_destination_address_prefixes": flattenArmEffectiveSecurityRuleValues(nil, rule.ExpandedDestinationAddressPrefix),
+		})
+	}
+
+	return results
+}
+
+// flattenArmEffectiveSecurityRuleValues combines the singular and plural forms of a field returned by the API
+// (e.g. `sourcePortRange` and `sourcePortRanges`) - since either (or both) can be populated for a given rule
+func flattenArmEffectiveSecurityRuleValues(single *string, multiple *[]string) []interface{} {
+	results := make([]interface{}, 0)
+
+	if single != nil && *single != "" {
+		results = append(results, *single)
+	}
+
+	if multiple != nil {
+		for _, v := range *multiple {
+			if single != nil && v == *single {
+				continue
+			}
+
+			results = append(results, v)
+		}
+	}
+
+	return results
+}
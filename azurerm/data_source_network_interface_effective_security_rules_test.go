@@ -0,0 +1,47 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMNetworkInterfaceEffectiveSecurityRules_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_network_interface_effective_security_rules.test"
+	ri := testAccRandTimeInt(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMNetworkInterfaceEffectiveSecurityRules_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "network_security_group.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "network_security_group.0.id", "azurerm_network_security_group.test", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "network_security_group.0.subnet_id", "azurerm_subnet.test", "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "network_security_group.0.security_rule.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_security_group.0.security_rule.0.name", "securityRules/allow-ssh"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_security_group.0.security_rule.0.priority", "100"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_security_group.0.security_rule.0.access", "Allow"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_security_group.0.security_rule.0.destination_port_ranges.0", "22-22"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMNetworkInterfaceEffectiveSecurityRules_basic(rInt int, location string) string {
+	template := testAccAzureRMNetworkInterfaceEffective_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_interface_effective_security_rules" "test" {
+  network_interface_name = "${azurerm_network_interface.test.name}"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+
+  depends_on = ["azurerm_virtual_machine.test"]
+}
+`, template)
+}
@@ -0,0 +1,111 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmNetworkWatcherNextHop() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmNetworkWatcherNextHopRead,
+
+		Schema: map[string]*schema.Schema{
+			"network_watcher_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"target_resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: ids.ValidateVirtualMachineID,
+			},
+
+			"target_network_interface_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: ids.ValidateNetworkInterfaceID,
+			},
+
+			"source_ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.IPv4Address,
+			},
+
+			"destination_ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.IPv4Address,
+			},
+
+			"next_hop_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"next_hop_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmNetworkWatcherNextHopRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().watcherClient
+	ctx := meta.(*ArmClient).StopContext
+
+	watcherName := d.Get("network_watcher_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	parameters := network.NextHopParameters{
+		TargetResourceID:     utils.String(d.Get("target_resource_id").(string)),
+		SourceIPAddress:      utils.String(d.Get("source_ip_address").(string)),
+		DestinationIPAddress: utils.String(d.Get("destination_ip_address").(string)),
+	}
+	if v, ok := d.GetOk("target_network_interface_id"); ok {
+		parameters.TargetNicResourceID = utils.String(v.(string))
+	}
+
+	future, err := client.GetNextHop(ctx, resourceGroup, watcherName, parameters)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error: Network Watcher %q (Resource Group %q) was not found", watcherName, resourceGroup)
+		}
+		return fmt.Errorf("Error retrieving Next Hop using Network Watcher %q (Resource Group %q): %+v", watcherName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Next Hop using Network Watcher %q (Resource Group %q): %+v", watcherName, resourceGroup, err)
+	}
+
+	result, err := future.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Next Hop using Network Watcher %q (Resource Group %q): %+v", watcherName, resourceGroup, err)
+	}
+
+	// the next hop is computed when it's requested, so there's no ID for this
+	d.SetId(time.Now().UTC().String())
+
+	d.Set("next_hop_type", string(result.NextHopType))
+	d.Set("next_hop_ip_address", result.NextHopIPAddress)
+	d.Set("route_table_id", result.RouteTableID)
+
+	return nil
+}
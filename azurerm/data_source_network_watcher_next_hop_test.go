@@ -0,0 +1,49 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func testAccDataSourceAzureRMNetworkWatcherNextHop_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_network_watcher_next_hop.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMNetworkWatcherNextHop_basicConfig(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "next_hop_type", "Internet"),
+					resource.TestCheckResourceAttr(dataSourceName, "route_table_id", "System Route"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMNetworkWatcherNextHop_basicConfig(rInt int, location string) string {
+	template := testAccAzureRMNetworkInterfaceEffective_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_watcher" "test" {
+  name                = "acctestnw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+data "azurerm_network_watcher_next_hop" "test" {
+  network_watcher_name   = "${azurerm_network_watcher.test.name}"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  target_resource_id     = "${azurerm_virtual_machine.test.id}"
+  source_ip_address      = "${azurerm_network_interface.test.private_ip_address}"
+  destination_ip_address = "8.8.8.8"
+}
+`, template, rInt)
+}
@@ -0,0 +1,75 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+)
+
+// the VPN Client Package is generated each time this is read, since the URL is only valid for a limited time
+func dataSourceArmPointToSiteVpnGatewayProfile() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmPointToSiteVpnGatewayProfileRead,
+
+		Schema: map[string]*schema.Schema{
+			"point_to_site_vpn_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: ids.ValidatePointToSiteVpnGatewayID,
+			},
+
+			"authentication_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(network.EAPTLS),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.EAPMSCHAPv2),
+					string(network.EAPTLS),
+				}, false),
+			},
+
+			"url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmPointToSiteVpnGatewayProfileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().p2sVpnGatewaysClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := ids.ParsePointToSiteVpnGatewayID(d.Get("point_to_site_vpn_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	parameters := network.P2SVpnProfileParameters{
+		AuthenticationMethod: network.AuthenticationMethod(d.Get("authentication_method").(string)),
+	}
+	future, err := client.GenerateVpnProfile(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error generating the VPN Client Package for Point-to-Site VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the VPN Client Package for Point-to-Site VPN Gateway %q (Resource Group %q) to be generated: %+v", name, resourceGroup, err)
+	}
+
+	profile, err := future.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the VPN Client Package for Point-to-Site VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(id.String())
+	d.Set("url", profile.ProfileURL)
+
+	return nil
+}
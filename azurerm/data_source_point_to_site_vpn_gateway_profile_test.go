@@ -0,0 +1,40 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMPointToSiteVpnGatewayProfile_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_point_to_site_vpn_gateway_profile.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPointToSiteVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMPointToSiteVpnGatewayProfile_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "authentication_method", "EAPTLS"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMPointToSiteVpnGatewayProfile_basic(rInt int, location string) string {
+	config := testAccAzureRMPointToSiteVpnGateway_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_point_to_site_vpn_gateway_profile" "test" {
+  point_to_site_vpn_gateway_id = "${azurerm_point_to_site_vpn_gateway.test.id}"
+}
+`, config)
+}
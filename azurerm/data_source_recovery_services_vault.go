@@ -34,7 +34,7 @@ func dataSourceArmRecoveryServicesVault() *schema.Resource {
 }
 
 func dataSourceArmRecoveryServicesVaultRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).recoveryServicesVaultsClient
+	client := meta.(*ArmClient).recoveryServices().recoveryServicesVaultsClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
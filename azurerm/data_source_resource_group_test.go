@@ -6,11 +6,10 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform/helper/resource"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
 func TestAccDataSourceAzureRMResourceGroup_basic(t *testing.T) {
-	ri := tf.AccRandTimeInt()
+	ri := testAccRandTimeInt(t)
 	name := fmt.Sprintf("acctestRg_%d", ri)
 	location := testLocation()
 
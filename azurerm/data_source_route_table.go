@@ -65,7 +65,7 @@ func dataSourceArmRouteTable() *schema.Resource {
 }
 
 func dataSourceArmRouteTableRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).routeTablesClient
+	client := meta.(*ArmClient).network().routeTablesClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
@@ -39,7 +39,7 @@ func dataSourceArmSharedImageGallery() *schema.Resource {
 }
 
 func dataSourceArmSharedImageGalleryRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).galleriesClient
+	client := meta.(*ArmClient).compute().galleriesClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
@@ -71,8 +71,8 @@ func dataSourceArmStreamAnalyticsJob() *schema.Resource {
 }
 
 func dataSourceArmStreamAnalyticsJobRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).streamAnalyticsJobsClient
-	transformationsClient := meta.(*ArmClient).streamAnalyticsTransformationsClient
+	client := meta.(*ArmClient).streamAnalytics().streamAnalyticsJobsClient
+	transformationsClient := meta.(*ArmClient).streamAnalytics().streamAnalyticsTransformationsClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
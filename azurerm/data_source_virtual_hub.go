@@ -0,0 +1,121 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmVirtualHub() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmVirtualHubRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"location": locationForDataSourceSchema(),
+
+			"virtual_wan_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"address_prefix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"route": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address_prefixes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"next_hop_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"virtual_network_connection_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmVirtualHubRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().virtualHubsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Virtual Hub %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Virtual Hub %q (Resource Group %q) ID", name, resourceGroup)
+	}
+	d.SetId(*resp.ID)
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.VirtualHubProperties; props != nil {
+		d.Set("address_prefix", props.AddressPrefix)
+
+		virtualWanId := ""
+		if props.VirtualWan != nil && props.VirtualWan.ID != nil {
+			virtualWanId = *props.VirtualWan.ID
+		}
+		d.Set("virtual_wan_id", virtualWanId)
+
+		if err := d.Set("route", flattenArmVirtualHubRoutes(props.RouteTable)); err != nil {
+			return fmt.Errorf("Error setting `route`: %+v", err)
+		}
+
+		connectionIds := make([]string, 0)
+		if props.VirtualNetworkConnections != nil {
+			for _, connection := range *props.VirtualNetworkConnections {
+				if connection.ID != nil {
+					connectionIds = append(connectionIds, *connection.ID)
+				}
+			}
+		}
+		if err := d.Set("virtual_network_connection_ids", connectionIds); err != nil {
+			return fmt.Errorf("Error setting `virtual_network_connection_ids`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMVirtualHub_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_virtual_hub.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMVirtualHub_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "virtual_wan_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "address_prefix", "10.0.1.0/24"),
+					resource.TestCheckResourceAttr(dataSourceName, "route.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "route.0.next_hop_ip_address", "12.34.56.78"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMVirtualHub_basic(rInt int, location string) string {
+	config := testAccAzureRMVirtualHub_routes(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_hub" "test" {
+  name                = "${azurerm_virtual_hub.test.name}"
+  resource_group_name = "${azurerm_virtual_hub.test.resource_group_name}"
+}
+`, config)
+}
@@ -54,7 +54,7 @@ func dataSourceArmVirtualNetwork() *schema.Resource {
 }
 
 func dataSourceArmVnetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vnetClient
+	client := meta.(*ArmClient).network().vnetClient
 	ctx := meta.(*ArmClient).StopContext
 
 	resGroup := d.Get("resource_group_name").(string)
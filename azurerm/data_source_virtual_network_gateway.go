@@ -186,7 +186,7 @@ func dataSourceArmVirtualNetworkGateway() *schema.Resource {
 }
 
 func dataSourceArmVirtualNetworkGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vnetGatewayClient
+	client := meta.(*ArmClient).network().vnetGatewayClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
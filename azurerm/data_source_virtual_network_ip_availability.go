@@ -0,0 +1,81 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmVirtualNetworkIPAvailability() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmVirtualNetworkIPAvailabilityRead,
+
+		Schema: map[string]*schema.Schema{
+			"virtual_network_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.IPv4Address,
+			},
+
+			"available": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"available_ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceArmVirtualNetworkIPAvailabilityRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().vnetClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("virtual_network_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	ipAddress := d.Get("ip_address").(string)
+
+	resp, err := client.CheckIPAddressAvailability(ctx, resourceGroup, name, ipAddress)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Virtual Network %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+		return fmt.Errorf("Error checking availability of IP Address %q in Virtual Network %q (Resource Group %q): %+v", ipAddress, name, resourceGroup, err)
+	}
+
+	// the availability is computed when it's requested, so there's no ID for this
+	d.SetId(time.Now().UTC().String())
+
+	available := false
+	if resp.Available != nil {
+		available = *resp.Available
+	}
+	d.Set("available", available)
+
+	availableIPAddresses := make([]interface{}, 0)
+	if resp.AvailableIPAddresses != nil {
+		for _, v := range *resp.AvailableIPAddresses {
+			availableIPAddresses = append(availableIPAddresses, v)
+		}
+	}
+	if err := d.Set("available_ip_addresses", availableIPAddresses); err != nil {
+		return fmt.Errorf("Error setting `available_ip_addresses`: %+v", err)
+	}
+
+	return nil
+}
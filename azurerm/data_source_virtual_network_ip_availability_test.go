@@ -0,0 +1,78 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMVirtualNetworkIPAvailability_available(t *testing.T) {
+	dataSourceName := "data.azurerm_virtual_network_ip_availability.test"
+	ri := testAccRandTimeInt(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMVirtualNetworkIPAvailability_basic(ri, testLocation(), "10.0.1.10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "available", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "available_ip_addresses.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMVirtualNetworkIPAvailability_unavailable(t *testing.T) {
+	dataSourceName := "data.azurerm_virtual_network_ip_availability.test"
+	ri := testAccRandTimeInt(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// the first addresses within a Subnet are reserved by Azure
+				Config: testAccDataSourceAzureRMVirtualNetworkIPAvailability_basic(ri, testLocation(), "10.0.1.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "available", "false"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "available_ip_addresses.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMVirtualNetworkIPAvailability_basic(rInt int, location string, ipAddress string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+
+data "azurerm_virtual_network_ip_availability" "test" {
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  ip_address           = "%s"
+
+  depends_on = ["azurerm_subnet.test"]
+}
+`, rInt, location, rInt, rInt, ipAddress)
+}
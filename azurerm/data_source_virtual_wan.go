@@ -0,0 +1,111 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmVirtualWan() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmVirtualWanRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"location": locationForDataSourceSchema(),
+
+			"disable_vpn_encryption": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"security_provider_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"allow_branch_to_branch_traffic": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"allow_vnet_to_vnet_traffic": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"office365_local_breakout_category": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"virtual_hub_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmVirtualWanRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().virtualWansClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Virtual WAN %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+		return fmt.Errorf("Error retrieving Virtual WAN %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Virtual WAN %q (Resource Group %q) ID", name, resourceGroup)
+	}
+	d.SetId(*resp.ID)
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.VirtualWanProperties; props != nil {
+		d.Set("disable_vpn_encryption", props.DisableVpnEncryption)
+		d.Set("security_provider_name", props.SecurityProviderName)
+		d.Set("allow_branch_to_branch_traffic", props.AllowBranchToBranchTraffic)
+		d.Set("allow_vnet_to_vnet_traffic", props.AllowVnetToVnetTraffic)
+		d.Set("office365_local_breakout_category", string(props.Office365LocalBreakoutCategory))
+
+		virtualHubIds := make([]string, 0)
+		if props.VirtualHubs != nil {
+			for _, hub := range *props.VirtualHubs {
+				if hub.ID != nil {
+					virtualHubIds = append(virtualHubIds, *hub.ID)
+				}
+			}
+		}
+		if err := d.Set("virtual_hub_ids", virtualHubIds); err != nil {
+			return fmt.Errorf("Error setting `virtual_hub_ids`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
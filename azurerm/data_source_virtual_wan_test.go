@@ -0,0 +1,57 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMVirtualWan_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_virtual_wan.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualWanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMVirtualWan_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "location"),
+					resource.TestCheckResourceAttr(dataSourceName, "allow_vnet_to_vnet_traffic", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "office365_local_breakout_category", "Optimize"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMVirtualWan_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_wan" "test" {
+  name                              = "acctestvwan%d"
+  resource_group_name               = "${azurerm_resource_group.test.name}"
+  location                          = "${azurerm_resource_group.test.location}"
+  allow_vnet_to_vnet_traffic        = true
+  office365_local_breakout_category = "Optimize"
+
+  tags = {
+    environment = "Production"
+  }
+}
+
+data "azurerm_virtual_wan" "test" {
+  name                = "${azurerm_virtual_wan.test.name}"
+  resource_group_name = "${azurerm_virtual_wan.test.resource_group_name}"
+}
+`, rInt, location, rInt)
+}
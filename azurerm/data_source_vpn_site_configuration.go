@@ -0,0 +1,85 @@
+package azurerm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the configuration of the VPN Sites is written to the specified Storage Blob, which can then be
+// used to configure the VPN Devices at each Site
+func dataSourceArmVpnSiteConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmVpnSiteConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"virtual_wan_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: ids.ValidateVirtualWanID,
+			},
+
+			"vpn_site_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: ids.ValidateVpnSiteID,
+				},
+			},
+
+			"output_blob_sas_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.URLIsHTTPS,
+			},
+		},
+	}
+}
+
+func dataSourceArmVpnSiteConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().vpnSitesConfigurationClient
+	ctx := meta.(*ArmClient).StopContext
+
+	virtualWanId, err := ids.ParseVirtualWanID(d.Get("virtual_wan_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := virtualWanId.ResourceGroup
+	virtualWanName := virtualWanId.Name
+
+	vpnSiteIds := make([]string, 0)
+	for _, v := range d.Get("vpn_site_ids").([]interface{}) {
+		vpnSiteIds = append(vpnSiteIds, v.(string))
+	}
+	outputBlobSasUrl := d.Get("output_blob_sas_url").(string)
+
+	request := network.GetVpnSitesConfigurationRequest{
+		VpnSites:         &vpnSiteIds,
+		OutputBlobSasURL: utils.String(outputBlobSasUrl),
+	}
+
+	future, err := client.Download(ctx, resourceGroup, virtualWanName, request)
+	if err != nil {
+		return fmt.Errorf("Error downloading the VPN Site Configuration for Virtual WAN %q (Resource Group %q): %+v", virtualWanName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the VPN Site Configuration for Virtual WAN %q (Resource Group %q) to be downloaded: %+v", virtualWanName, resourceGroup, err)
+	}
+
+	// the SAS URL is sensitive, so the ID is a hash of the request
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%s", virtualWanId.String(), strings.Join(vpnSiteIds, ","), outputBlobSasUrl)))
+	d.SetId(hex.EncodeToString(hash[:]))
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMVpnSiteConfiguration_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_vpn_site_configuration.test"
+	ri := testAccRandTimeInt(t)
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+	utcNow := time.Now().UTC()
+	startDate := utcNow.Format(time.RFC3339)
+	endDate := utcNow.Add(time.Hour * 24).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMVpnSiteConfiguration_basic(ri, rs, location, startDate, endDate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpn_site_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMVpnSiteConfiguration_basic(rInt int, rString string, location string, startDate string, endDate string) string {
+	config := testAccAzureRMVpnGatewayConnection_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vpnconfig"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+
+data "azurerm_storage_account_sas" "test" {
+  connection_string = "${azurerm_storage_account.test.primary_connection_string}"
+  https_only        = true
+
+  resource_types {
+    service   = false
+    container = false
+    object    = true
+  }
+
+  services {
+    blob  = true
+    queue = false
+    table = false
+    file  = false
+  }
+
+  start  = "%s"
+  expiry = "%s"
+
+  permissions {
+    read    = true
+    write   = true
+    delete  = false
+    list    = false
+    add     = true
+    create  = true
+    update  = false
+    process = false
+  }
+}
+
+data "azurerm_vpn_site_configuration" "test" {
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  vpn_site_ids        = ["${azurerm_vpn_gateway_connection.test.remote_vpn_site_id}"]
+  output_blob_sas_url = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}/config.json${data.azurerm_storage_account_sas.test.sas}"
+}
+`, config, rString, startDate, endDate)
+}
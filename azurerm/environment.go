@@ -0,0 +1,74 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+
+	az "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+)
+
+// loadEnvironment returns the Azure Environment containing the endpoints (and DNS suffixes) used by the Provider,
+// which is loaded from either a JSON file, the metadata endpoint of a Resource Manager (such as an Azure Stack Hub)
+// or is one of the well-known Azure Clouds (e.g. `public` or `china`)
+func loadEnvironment(name, metadataHost, environmentFile string) (*az.Environment, error) {
+	if metadataHost != "" && environmentFile != "" {
+		return nil, fmt.Errorf("Only one of `metadata_host` and `environment_file` can be specified")
+	}
+
+	if environmentFile != "" {
+		env, err := az.EnvironmentFromFile(environmentFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error loading the Environment from %q: %+v", environmentFile, err)
+		}
+
+		if err := validateCustomEnvironment(&env); err != nil {
+			return nil, fmt.Errorf("Error loading the Environment from %q: %+v", environmentFile, err)
+		}
+
+		return &env, nil
+	}
+
+	if metadataHost != "" {
+		env, err := authentication.LoadEnvironmentFromUrl(metadataHostUrl(metadataHost))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := validateCustomEnvironment(env); err != nil {
+			return nil, fmt.Errorf("Error loading the Environment from the Metadata Host %q: %+v", metadataHost, err)
+		}
+
+		return env, nil
+	}
+
+	return authentication.DetermineEnvironment(name)
+}
+
+// metadataHostUrl returns the URL of the Resource Manager for the Metadata Host, which
+// can either be a hostname (e.g. `management.local.azurestack.external`) or a URL
+func metadataHostUrl(metadataHost string) string {
+	if strings.Contains(metadataHost, "://") {
+		return metadataHost
+	}
+
+	return fmt.Sprintf("https://%s/", metadataHost)
+}
+
+// validateCustomEnvironment ensures the endpoints required by the Provider are set within a custom Environment,
+// defaulting the Audience of the tokens to the Resource Manager endpoint (as for the well-known Azure Clouds)
+func validateCustomEnvironment(env *az.Environment) error {
+	if env.ResourceManagerEndpoint == "" {
+		return fmt.Errorf("`resourceManagerEndpoint` must be specified")
+	}
+
+	if env.ActiveDirectoryEndpoint == "" {
+		return fmt.Errorf("`activeDirectoryEndpoint` must be specified")
+	}
+
+	if env.TokenAudience == "" {
+		env.TokenAudience = env.ResourceManagerEndpoint
+	}
+
+	return nil
+}
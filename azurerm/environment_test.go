@@ -0,0 +1,157 @@
+package azurerm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestLoadEnvironment_name(t *testing.T) {
+	env, err := loadEnvironment("china", "", "")
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if env.Name != "AzureChinaCloud" {
+		t.Fatalf("Expected the Environment to be %q but got %q", "AzureChinaCloud", env.Name)
+	}
+
+	if _, err := loadEnvironment("moon", "", ""); err == nil {
+		t.Fatalf("Expected an error loading an unknown Environment but didn't get one")
+	}
+}
+
+func TestLoadEnvironment_environmentFile(t *testing.T) {
+	testData := []struct {
+		Name        string
+		Contents    string
+		ExpectError bool
+	}{
+		{
+			Name:        "Invalid JSON",
+			Contents:    "{",
+			ExpectError: true,
+		},
+		{
+			Name:        "Missing Resource Manager Endpoint",
+			Contents:    `{"activeDirectoryEndpoint": "https://login.example.com/"}`,
+			ExpectError: true,
+		},
+		{
+			Name:        "Missing Active Directory Endpoint",
+			Contents:    `{"resourceManagerEndpoint": "https://management.example.com/"}`,
+			ExpectError: true,
+		},
+		{
+			Name: "Valid",
+			Contents: `{
+  "name": "ExampleCloud",
+  "resourceManagerEndpoint": "https://management.example.com/",
+  "activeDirectoryEndpoint": "https://login.example.com/",
+  "graphEndpoint": "https://graph.example.com/",
+  "storageEndpointSuffix": "storage.example.com",
+  "keyVaultDNSSuffix": "vault.example.com"
+}`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		file, err := ioutil.TempFile("", "environment")
+		if err != nil {
+			t.Fatalf("Error creating the Environment File: %+v", err)
+		}
+		defer os.Remove(file.Name())
+
+		if _, err := file.WriteString(v.Contents); err != nil {
+			t.Fatalf("Error writing the Environment File: %+v", err)
+		}
+		file.Close()
+
+		env, err := loadEnvironment("public", "", file.Name())
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Expected no error for %q but got: %+v", v.Name, err)
+		}
+
+		if v.ExpectError {
+			t.Fatalf("Expected an error for %q but didn't get one", v.Name)
+		}
+
+		if env.StorageEndpointSuffix != "storage.example.com" {
+			t.Fatalf("Expected the Storage Endpoint Suffix to be %q but got %q", "storage.example.com", env.StorageEndpointSuffix)
+		}
+
+		if env.KeyVaultDNSSuffix != "vault.example.com" {
+			t.Fatalf("Expected the Key Vault DNS Suffix to be %q but got %q", "vault.example.com", env.KeyVaultDNSSuffix)
+		}
+
+		if env.TokenAudience != "https://management.example.com/" {
+			t.Fatalf("Expected the Token Audience to default to the Resource Manager Endpoint but got %q", env.TokenAudience)
+		}
+	}
+}
+
+func TestLoadEnvironment_metadataHost(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/metadata/endpoints" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+  "galleryEndpoint": "https://portal.local.azurestack.external:30015/",
+  "graphEndpoint": "https://graph.windows.net/",
+  "portalEndpoint": "https://portal.local.azurestack.external/",
+  "authentication": {
+    "loginEndpoint": "https://login.windows.net/",
+    "audiences": ["https://management.example.onmicrosoft.com/00000000-0000-0000-0000-000000000000"]
+  }
+}`)
+	}))
+	defer server.Close()
+
+	env, err := loadEnvironment("public", server.URL, "")
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if env.ResourceManagerEndpoint != server.URL {
+		t.Fatalf("Expected the Resource Manager Endpoint to be %q but got %q", server.URL, env.ResourceManagerEndpoint)
+	}
+
+	if env.ActiveDirectoryEndpoint != "https://login.windows.net/" {
+		t.Fatalf("Expected the Active Directory Endpoint to be %q but got %q", "https://login.windows.net/", env.ActiveDirectoryEndpoint)
+	}
+
+	if env.TokenAudience != "https://management.example.onmicrosoft.com/00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected the Token Audience to be loaded from the Metadata Host but got %q", env.TokenAudience)
+	}
+}
+
+func TestLoadEnvironment_conflicting(t *testing.T) {
+	if _, err := loadEnvironment("public", "management.local.azurestack.external", "/tmp/environment.json"); err == nil {
+		t.Fatalf("Expected an error specifying both a Metadata Host and an Environment File but didn't get one")
+	}
+}
+
+func TestMetadataHostUrl(t *testing.T) {
+	testData := map[string]string{
+		"management.local.azurestack.external":          "https://management.local.azurestack.external/",
+		"https://management.local.azurestack.external/": "https://management.local.azurestack.external/",
+	}
+
+	for input, expected := range testData {
+		if actual := metadataHostUrl(input); actual != expected {
+			t.Fatalf("Expected %q for %q but got %q", expected, input, actual)
+		}
+	}
+}
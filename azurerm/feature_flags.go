@@ -5,9 +5,9 @@ import (
 	"strings"
 )
 
-// NOTE: we'll need to add an infobox to MySQL|PostgreSQL Configuration when this goes live
-// since these resources can't support import
-// in addition the virtual resources will need adjusting
-
 // This file contains feature flags for functionality which will prove more challenging to implement en-mass
-var requireResourcesToBeImported = strings.EqualFold(os.Getenv("ARM_PROVIDER_STRICT"), "true")
+
+// requireResourcesToBeImported determines whether resources check for an existing resource prior to creation,
+// returning an error stating that it needs to be imported into the State if one exists. This is enabled by default
+// and can be disabled by setting the Environment Variable `ARM_PROVIDER_STRICT` to `false`.
+var requireResourcesToBeImported = !strings.EqualFold(os.Getenv("ARM_PROVIDER_STRICT"), "false")
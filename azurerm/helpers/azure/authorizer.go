@@ -0,0 +1,70 @@
+package azure
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// auxiliaryAuthorizationHeader is the header used to send tokens for additional (auxiliary) tenants,
+// which is required for cross-tenant operations such as peering Virtual Networks in another tenant
+const auxiliaryAuthorizationHeader = "x-ms-authorization-auxiliary"
+
+// maxAuxiliaryTenants is the maximum number of Auxiliary Tenants supported by Azure Resource Manager
+const maxAuxiliaryTenants = 3
+
+type auxiliaryTenantsAuthorizer struct {
+	primary     autorest.Authorizer
+	auxiliaries []autorest.Authorizer
+}
+
+// NewAuxiliaryTenantsAuthorizer returns an Authorizer which authorizes requests using the `primary` Authorizer
+// and additionally sends a token obtained from each of the `auxiliaries` in the `x-ms-authorization-auxiliary` header
+func NewAuxiliaryTenantsAuthorizer(primary autorest.Authorizer, auxiliaries []autorest.Authorizer) (autorest.Authorizer, error) {
+	if len(auxiliaries) > maxAuxiliaryTenants {
+		return nil, fmt.Errorf("At most %d Auxiliary Tenants are supported but got %d", maxAuxiliaryTenants, len(auxiliaries))
+	}
+
+	if len(auxiliaries) == 0 {
+		return primary, nil
+	}
+
+	return auxiliaryTenantsAuthorizer{
+		primary:     primary,
+		auxiliaries: auxiliaries,
+	}, nil
+}
+
+func (a auxiliaryTenantsAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := a.primary.WithAuthorization()(p).Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			tokens := make([]string, 0)
+			for _, auxiliary := range a.auxiliaries {
+				// the token for each tenant is obtained (and refreshed when necessary) by authorizing a throwaway request
+				uri := *r.URL
+				tokenRequest := (&http.Request{Method: r.Method, URL: &uri, Header: http.Header{}}).WithContext(r.Context())
+				tokenRequest, err := autorest.Prepare(tokenRequest, auxiliary.WithAuthorization())
+				if err != nil {
+					return r, fmt.Errorf("Error obtaining an Authorization Token for an Auxiliary Tenant: %+v", err)
+				}
+
+				if token := tokenRequest.Header.Get("Authorization"); token != "" {
+					tokens = append(tokens, token)
+				}
+			}
+
+			if len(tokens) > 0 {
+				r.Header.Set(auxiliaryAuthorizationHeader, strings.Join(tokens, ", "))
+			}
+
+			return r, nil
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package azure
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestAuxiliaryTenantsAuthorizer(t *testing.T) {
+	primary := autorest.NewAPIKeyAuthorizerWithHeaders(map[string]interface{}{
+		"Authorization": "Bearer primary",
+	})
+	auxiliary := func(token string) autorest.Authorizer {
+		return autorest.NewAPIKeyAuthorizerWithHeaders(map[string]interface{}{
+			"Authorization": "Bearer " + token,
+		})
+	}
+
+	testCases := []struct {
+		name        string
+		auxiliaries []autorest.Authorizer
+		expected    string
+		expectError bool
+	}{
+		{
+			name:        "No Auxiliary Tenants",
+			auxiliaries: []autorest.Authorizer{},
+			expected:    "",
+		},
+		{
+			name:        "Single Auxiliary Tenant",
+			auxiliaries: []autorest.Authorizer{auxiliary("first")},
+			expected:    "Bearer first",
+		},
+		{
+			name:        "Multiple Auxiliary Tenants",
+			auxiliaries: []autorest.Authorizer{auxiliary("first"), auxiliary("second"), auxiliary("third")},
+			expected:    "Bearer first, Bearer second, Bearer third",
+		},
+		{
+			name:        "Too Many Auxiliary Tenants",
+			auxiliaries: []autorest.Authorizer{auxiliary("first"), auxiliary("second"), auxiliary("third"), auxiliary("fourth")},
+			expectError: true,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Run(v.name, func(t *testing.T) {
+			authorizer, err := NewAuxiliaryTenantsAuthorizer(primary, v.auxiliaries)
+			if v.expectError {
+				if err == nil {
+					t.Fatalf("Expected an error but didn't get one")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions", nil)
+			req, err = autorest.Prepare(req, authorizer.WithAuthorization())
+			if err != nil {
+				t.Fatalf("Error authorizing request: %+v", err)
+			}
+
+			if actual := req.Header.Get("Authorization"); actual != "Bearer primary" {
+				t.Fatalf("Expected the Authorization header to be %q but got %q", "Bearer primary", actual)
+			}
+
+			if actual := req.Header.Get(auxiliaryAuthorizationHeader); actual != v.expected {
+				t.Fatalf("Expected the %s header to be %q but got %q", auxiliaryAuthorizationHeader, v.expected, actual)
+			}
+		})
+	}
+}
@@ -1,57 +1,307 @@
 package azure
 
 import (
+	"io"
+	"io/ioutil"
 	"log"
+	"math/rand"
+	"net"
 	"net/http"
 	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
-func BuildSender() autorest.Sender {
+// SenderOptions configures the behaviour of the Sender used by the clients
+type SenderOptions struct {
+	// MaxRetries is the number of times a request which has been throttled, failed with
+	// a transient error or had its connection dropped will be retried
+	MaxRetries int
+
+	// MaxRetryDelay is the upper bound for the delay between two attempts - which applies both to
+	// the exponential backoff and to any delay requested by Azure via the `Retry-After` header
+	MaxRetryDelay time.Duration
+
+	// LogSummaryOnly logs only the Method, URL, Status Code and Latency of each request,
+	// rather than the (redacted) Headers and Body of each Request and Response
+	LogSummaryOnly bool
+
+	// Decorators wrap the underlying HTTP Client (within the Logging and Retry behaviours) - which allows
+	// Acceptance Tests to record and play back the HTTP Interactions with Azure
+	Decorators []autorest.SendDecorator
+}
+
+// retryMinDelay is the delay used for the first retry, which is doubled for each subsequent attempt
+const retryMinDelay = 2 * time.Second
+
+// retryableStatusCodes are the HTTP Status Codes returned when a request has been throttled
+// or has failed for a transient reason - and as such can be retried
+var retryableStatusCodes = []int{
+	http.StatusRequestTimeout,
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// rejectedStatusCodes are the HTTP Status Codes returned when a request has been rejected before
+// being processed - which means requests which aren't idempotent can be retried when Azure
+// specifies (via the `Retry-After` header) when to do so
+var rejectedStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusServiceUnavailable,
+}
+
+// DisableClientRetries configures the SDK Client to leave retrying requests to the Sender built by BuildSender.
+// Otherwise the SDK's own retries (which retry throttled requests indefinitely) would be multiplied by those
+// of the Sender.
+func DisableClientRetries(client *autorest.Client) {
+	// NOTE: `DoRetryWithRegistration` re-sends a request after registering a Resource Provider only when
+	// it has an attempt remaining - and as such this needs to be at least 2.
+	client.RetryAttempts = 2
+
+	client.Sender = withoutClientRetries(client.Sender)
+}
+
+// withoutClientRetries surfaces any response which the SDK would retry (that is, one with a Status Code in
+// `autorest.StatusCodesForRetry`) as a non-temporary error - since the Sender has already retried the
+// request where it's safe to do so. The error contains the response, so the details from Azure are retained.
+func withoutClientRetries(s autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		resp, err := s.Do(r)
+		if err != nil || !autorest.ResponseHasStatusCode(resp, autorest.StatusCodesForRetry...) {
+			return resp, err
+		}
+
+		// this reads the body into memory, such that it can still be read from the response
+		responseErr := autorest.Respond(resp, az.WithErrorUnlessStatusCode())
+		return resp, nonRetryableError{responseErr}
+	})
+}
+
+func BuildSender(options SenderOptions) autorest.Sender {
+	decorators := make([]autorest.SendDecorator, 0, len(options.Decorators)+2)
+	decorators = append(decorators, options.Decorators...)
+	decorators = append(decorators, withRequestLogging(options.LogSummaryOnly), withRetries(options.MaxRetries, options.MaxRetryDelay))
 	return autorest.DecorateSender(&http.Client{
 		Transport: &http.Transport{
 			Proxy: http.ProxyFromEnvironment,
 		},
-	}, withRequestLogging())
+	}, decorators...)
 }
 
-func withRequestLogging() autorest.SendDecorator {
+// withRequestLogging logs each request and response at the DEBUG level - redacting any secrets (such as
+// access tokens, keys, passwords and the signatures of Shared Access Signatures) prior to printing. When
+// `summaryOnly` is set only the Method, URL, Status Code and Latency are logged.
+func withRequestLogging(summaryOnly bool) autorest.SendDecorator {
 	return func(s autorest.Sender) autorest.Sender {
 		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
-			// strip the authorization header prior to printing
-			authHeaderName := "Authorization"
-			auth := r.Header.Get(authHeaderName)
-			if auth != "" {
-				r.Header.Del(authHeaderName)
-			}
-
-			// dump request to wire format
-			if dump, err := httputil.DumpRequestOut(r, true); err == nil {
-				log.Printf("[DEBUG] AzureRM Request: \n%s\n", dump)
+			if summaryOnly {
+				log.Printf("[DEBUG] AzureRM Request: %s to %s\n", r.Method, redactUrl(r.URL))
+			} else if dump, err := httputil.DumpRequestOut(r, true); err == nil {
+				// dump request to wire format
+				log.Printf("[DEBUG] AzureRM Request: \n%s\n", redactDump(string(dump), r.URL))
 			} else {
 				// fallback to basic message
-				log.Printf("[DEBUG] AzureRM Request: %s to %s\n", r.Method, r.URL)
-			}
-
-			// add the auth header back
-			if auth != "" {
-				r.Header.Add(authHeaderName, auth)
+				log.Printf("[DEBUG] AzureRM Request: %s to %s\n", r.Method, redactUrl(r.URL))
 			}
 
+			start := time.Now()
 			resp, err := s.Do(r)
+			latency := time.Since(start)
+
 			if resp != nil {
-				// dump response to wire format
-				if dump, err2 := httputil.DumpResponse(resp, true); err2 == nil {
-					log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", r.URL, dump)
+				if summaryOnly {
+					log.Printf("[DEBUG] AzureRM Response: %s for %s to %s (took %s)\n", resp.Status, r.Method, redactUrl(r.URL), latency)
+				} else if dump, err2 := httputil.DumpResponse(resp, true); err2 == nil {
+					// dump response to wire format
+					log.Printf("[DEBUG] AzureRM Response for %s (took %s): \n%s\n", redactUrl(r.URL), latency, redactDump(string(dump), r.URL))
 				} else {
 					// fallback to basic message
-					log.Printf("[DEBUG] AzureRM Response: %s for %s\n", resp.Status, r.URL)
+					log.Printf("[DEBUG] AzureRM Response: %s for %s (took %s)\n", resp.Status, redactUrl(r.URL), latency)
 				}
 			} else {
-				log.Printf("[DEBUG] Request to %s completed with no response", r.URL)
+				log.Printf("[DEBUG] Request to %s completed with no response (took %s)", redactUrl(r.URL), latency)
 			}
 			return resp, err
 		})
 	}
 }
+
+// withRetries retries requests which have been throttled, failed with a transient error or which
+// had their connection dropped - backing off exponentially (with jitter) between attempts, unless
+// Azure specifies how long to wait via the `Retry-After` header.
+func withRetries(maxRetries int, maxDelay time.Duration) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+			for attempt := 0; ; attempt++ {
+				if err := rr.Prepare(); err != nil {
+					return nil, err
+				}
+
+				resp, err := s.Do(rr.Request())
+				if attempt >= maxRetries || !shouldRetryRequest(r, resp, err) {
+					if err != nil {
+						// we've already retried this request where it's safe to do so
+						err = nonRetryableError{err}
+					}
+					return resp, err
+				}
+
+				delay := retryDelay(resp, attempt, maxDelay)
+				if resp != nil {
+					log.Printf("[DEBUG] AzureRM Request to %s returned %q - retrying in %s (retry %d of %d)", redactUrl(r.URL), resp.Status, delay, attempt+1, maxRetries)
+					drainAndClose(resp)
+				} else {
+					log.Printf("[DEBUG] AzureRM Request to %s failed with %q - retrying in %s (retry %d of %d)", redactUrl(r.URL), err, delay, attempt+1, maxRetries)
+				}
+
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+					return nil, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
+// nonRetryableError wraps an error returned by the Sender as a `net.Error` which isn't temporary - since
+// the SDK otherwise considers any error temporary, and would re-send requests (including non-idempotent ones)
+type nonRetryableError struct {
+	err error
+}
+
+func (e nonRetryableError) Error() string {
+	return e.err.Error()
+}
+
+func (e nonRetryableError) Timeout() bool {
+	if netErr, ok := e.err.(net.Error); ok {
+		return netErr.Timeout()
+	}
+
+	return false
+}
+
+func (e nonRetryableError) Temporary() bool {
+	return false
+}
+
+func shouldRetryRequest(r *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		// an invalid token won't become valid by retrying
+		if autorest.IsTokenRefreshError(err) {
+			return false
+		}
+
+		// the request may have been processed before the connection dropped, so we can only
+		// re-send requests which are safe to send multiple times
+		return isIdempotentMethod(r.Method) && isTransientNetworkError(err)
+	}
+
+	if isIdempotentMethod(r.Method) {
+		return autorest.ResponseHasStatusCode(resp, retryableStatusCodes...)
+	}
+
+	// otherwise the request may have been (partially) processed before failing - for example a POST
+	// to regenerate a key - so we only retry once Azure has told us it's been rejected
+	return autorest.ResponseHasStatusCode(resp, rejectedStatusCodes...) && resp.Header.Get("Retry-After") != ""
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+func isTransientNetworkError(err error) bool {
+	if utils.ResponseErrorIsRetryable(err) {
+		return true
+	}
+
+	if urlErr, ok := err.(*url.Error); ok {
+		err = urlErr.Err
+	}
+
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return true
+	}
+
+	// these aren't always surfaced as a `net.Error`
+	msg := err.Error()
+	return strings.Contains(msg, "connection reset by peer") || strings.Contains(msg, "broken pipe")
+}
+
+// retryDelay returns how long to wait before retrying the request, preferring the value
+// requested by Azure in the `Retry-After` header over an exponential backoff - both of
+// which are capped at `maxDelay`
+func retryDelay(resp *http.Response, attempt int, maxDelay time.Duration) time.Duration {
+	if resp != nil {
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if delay > maxDelay {
+				return maxDelay
+			}
+
+			return delay
+		}
+	}
+
+	backoff := maxDelay
+	if attempt < 30 {
+		if exponential := retryMinDelay * time.Duration(1<<uint(attempt)); exponential < maxDelay {
+			backoff = exponential
+		}
+	}
+
+	// wait somewhere between half and the full backoff, to avoid parallel requests retrying in lockstep
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// parseRetryAfter parses the value of a `Retry-After` header, which is either a number
+// of seconds or a HTTP Date
+func parseRetryAfter(input string) (time.Duration, bool) {
+	if input == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(input); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(input); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+
+		return delay, true
+	}
+
+	return 0, false
+}
+
+func drainAndClose(resp *http.Response) {
+	if resp.Body == nil {
+		return
+	}
+
+	io.Copy(ioutil.Discard, resp.Body) //nolint: errcheck
+	resp.Body.Close()
+}
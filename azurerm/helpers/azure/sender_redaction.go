@@ -0,0 +1,161 @@
+package azure
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const redactedValue = "**REDACTED**"
+
+// sensitiveHeaders are the HTTP Headers whose values are never logged
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Ocp-Apim-Subscription-Key",
+	"Set-Cookie",
+	"X-Ms-Authorization-Auxiliary",
+}
+
+var (
+	// matches `"name": "value"` pairs within a JSON document
+	redactionJsonPropertyRegex = regexp.MustCompile(`"([^"\\]+)"(\s*:\s*)"((?:[^"\\]|\\.)*)"`)
+
+	// matches `name=value` pairs within a form-encoded body (e.g. requests for an access token)
+	redactionFormValueRegex = regexp.MustCompile(`(^|&)([^=&\s]+)=([^&\s]*)`)
+
+	// matches the signature of a Shared Access Signature, within a URL or a body
+	redactionSasSignatureRegex = regexp.MustCompile(`((?:[?&]|&amp;|\\u0026)sig=)[^&"'\s\\]+`)
+)
+
+// redactDump removes secrets from the wire-format dump of a HTTP Request/Response to/from the specified URL
+func redactDump(dump string, uri *url.URL) string {
+	headers := dump
+	body := ""
+	if i := strings.Index(dump, "\r\n\r\n"); i >= 0 {
+		headers = dump[:i]
+		body = dump[i:]
+	}
+
+	lines := strings.Split(headers, "\r\n")
+	for i, line := range lines {
+		sep := strings.Index(line, ":")
+		if sep < 0 {
+			continue
+		}
+
+		if IsSensitiveHeader(strings.TrimSpace(line[:sep])) {
+			lines[i] = line[:sep] + ": " + redactedValue
+		}
+	}
+
+	return redactSasSignatures(strings.Join(lines, "\r\n") + redactBody(body, uri))
+}
+
+// IsSensitiveHeader determines whether the value of the specified HTTP Header contains a secret
+func IsSensitiveHeader(name string) bool {
+	for _, header := range sensitiveHeaders {
+		if strings.EqualFold(name, header) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RedactBody masks the values of sensitive properties, and the signature of any Shared Access Signature,
+// within the body of a Request or Response to/from the specified URL
+func RedactBody(body string, uri *url.URL) string {
+	return redactSasSignatures(redactBody(body, uri))
+}
+
+// redactBody masks the values of sensitive properties within a JSON or form-encoded body
+func redactBody(body string, uri *url.URL) string {
+	redactValues := responseContainsSecretValues(uri)
+
+	body = redactionJsonPropertyRegex.ReplaceAllStringFunc(body, func(match string) string {
+		groups := redactionJsonPropertyRegex.FindStringSubmatch(match)
+		if !isSensitiveProperty(groups[1], redactValues) {
+			return match
+		}
+
+		return `"` + groups[1] + `"` + groups[2] + `"` + redactedValue + `"`
+	})
+
+	if !strings.HasPrefix(strings.TrimSpace(body), "{") && !strings.HasPrefix(strings.TrimSpace(body), "[") {
+		lines := strings.Split(body, "\n")
+		for i, line := range lines {
+			lines[i] = redactionFormValueRegex.ReplaceAllStringFunc(line, func(match string) string {
+				groups := redactionFormValueRegex.FindStringSubmatch(match)
+				if !isSensitiveProperty(groups[2], false) {
+					return match
+				}
+
+				return groups[1] + groups[2] + "=" + redactedValue
+			})
+		}
+		body = strings.Join(lines, "\n")
+	}
+
+	return body
+}
+
+// redactSasSignatures masks the signature of any Shared Access Signature within the input
+func redactSasSignatures(input string) string {
+	return redactionSasSignatureRegex.ReplaceAllString(input, "${1}"+redactedValue)
+}
+
+// redactUrl returns the URL with the signature of any Shared Access Signature masked
+func redactUrl(uri *url.URL) string {
+	if uri == nil {
+		return ""
+	}
+
+	return redactSasSignatures(uri.String())
+}
+
+// RedactRequestUri returns the Request URI (that is, the Path and Query) of the URL with the signature
+// of any Shared Access Signature masked
+func RedactRequestUri(uri *url.URL) string {
+	return redactSasSignatures(uri.RequestURI())
+}
+
+// responseContainsSecretValues determines whether the `value` properties returned from the specified
+// URL contain secrets - such as Key Vault Secrets, or the Keys/Connection Strings/Credentials listed
+// or regenerated for a resource (e.g. a Storage Account)
+func responseContainsSecretValues(uri *url.URL) bool {
+	if uri == nil {
+		return false
+	}
+
+	path := strings.ToLower(strings.TrimSuffix(uri.Path, "/"))
+	if strings.Contains(path, "/secrets") {
+		return true
+	}
+
+	segments := strings.Split(path, "/")
+	action := segments[len(segments)-1]
+	return strings.HasPrefix(action, "list") || strings.HasPrefix(action, "regenerate")
+}
+
+// isSensitiveProperty determines whether the property/field with the specified name contains a secret
+func isSensitiveProperty(name string, redactValues bool) bool {
+	name = strings.ToLower(strings.Replace(name, "_", "", -1))
+
+	if redactValues && name == "value" {
+		return true
+	}
+
+	for _, v := range []string{"password", "secret", "connectionstring", "credential", "assertion"} {
+		if strings.Contains(name, v) {
+			return true
+		}
+	}
+
+	// e.g. `primaryKey`, `storageAccountKey` or `access_token` - but not an (SSH) public key
+	if strings.HasSuffix(name, "key") || strings.HasSuffix(name, "token") {
+		return !strings.Contains(name, "public")
+	}
+
+	return false
+}
@@ -0,0 +1,176 @@
+package azure
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestRedactDump(t *testing.T) {
+	testCases := []struct {
+		name        string
+		url         string
+		dump        string
+		contains    []string
+		notContains []string
+	}{
+		{
+			name: "Sensitive Headers",
+			url:  "https://management.azure.com/subscriptions",
+			dump: "GET /subscriptions HTTP/1.1\r\nHost: management.azure.com\r\nAuthorization: Bearer abc123\r\nCookie: session=abc123\r\nX-Ms-Authorization-Auxiliary: Bearer abc123\r\nUser-Agent: Terraform\r\n\r\n",
+			contains: []string{
+				"Authorization: **REDACTED**",
+				"Cookie: **REDACTED**",
+				"X-Ms-Authorization-Auxiliary: **REDACTED**",
+				"User-Agent: Terraform",
+			},
+			notContains: []string{"abc123"},
+		},
+		{
+			name: "Set-Cookie Response Header",
+			url:  "https://management.azure.com/subscriptions",
+			dump: "HTTP/1.1 200 OK\r\nSet-Cookie: x-ms-gateway-slice=abc123; path=/\r\n\r\n{}",
+			contains: []string{
+				"Set-Cookie: **REDACTED**",
+			},
+			notContains: []string{"abc123"},
+		},
+		{
+			name: "Sensitive JSON Properties",
+			url:  "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1",
+			dump: "PUT /subscriptions HTTP/1.1\r\nHost: management.azure.com\r\n\r\n{\"location\":\"westeurope\",\"properties\":{\"administratorLogin\":\"admin\",\"administratorLoginPassword\": \"abc123\",\"primaryConnectionString\":\"abc123\",\"storageAccountKey\":\"abc123\",\"sshPublicKey\":\"ssh-rsa AAAA\"}}",
+			contains: []string{
+				`"administratorLogin":"admin"`,
+				`"administratorLoginPassword": "**REDACTED**"`,
+				`"primaryConnectionString":"**REDACTED**"`,
+				`"storageAccountKey":"**REDACTED**"`,
+				`"sshPublicKey":"ssh-rsa AAAA"`,
+				`"location":"westeurope"`,
+			},
+			notContains: []string{"abc123"},
+		},
+		{
+			name: "Escaped Quotes within a Sensitive Property",
+			url:  "https://management.azure.com/subscriptions",
+			dump: "HTTP/1.1 200 OK\r\n\r\n{\"password\":\"abc\\\"123\",\"name\":\"example\"}",
+			contains: []string{
+				`"password":"**REDACTED**"`,
+				`"name":"example"`,
+			},
+			notContains: []string{"abc", "123"},
+		},
+		{
+			name: "Key Vault Secret Value",
+			url:  "https://example.vault.azure.net/secrets/example/abc?api-version=2016-10-01",
+			dump: "HTTP/1.1 200 OK\r\n\r\n{\"value\":\"abc123\",\"id\":\"https://example.vault.azure.net/secrets/example/abc\"}",
+			contains: []string{
+				`"value":"**REDACTED**"`,
+				`"id":"https://example.vault.azure.net/secrets/example/abc"`,
+			},
+			notContains: []string{"abc123"},
+		},
+		{
+			name: "Listed Keys",
+			url:  "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/listKeys",
+			dump: "HTTP/1.1 200 OK\r\n\r\n{\"keys\":[{\"keyName\":\"key1\",\"value\":\"abc123\",\"permissions\":\"FULL\"}]}",
+			contains: []string{
+				`"keyName":"key1"`,
+				`"value":"**REDACTED**"`,
+				`"permissions":"FULL"`,
+			},
+			notContains: []string{"abc123"},
+		},
+		{
+			name: "Value isn't redacted elsewhere",
+			url:  "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			dump: "HTTP/1.1 200 OK\r\n\r\n{\"tags\":{\"value\":\"example\"}}",
+			contains: []string{
+				`"value":"example"`,
+			},
+		},
+		{
+			name: "Form Encoded Token Request",
+			url:  "https://login.microsoftonline.com/00000000-0000-0000-0000-000000000000/oauth2/token",
+			dump: "POST /00000000-0000-0000-0000-000000000000/oauth2/token HTTP/1.1\r\nContent-Type: application/x-www-form-urlencoded\r\n\r\nclient_id=abc&client_secret=abc123&grant_type=client_credentials&resource=https%3A%2F%2Fmanagement.azure.com%2F",
+			contains: []string{
+				"client_id=abc&",
+				"client_secret=**REDACTED**",
+				"grant_type=client_credentials",
+			},
+			notContains: []string{"abc123"},
+		},
+		{
+			name: "Token Response",
+			url:  "https://login.microsoftonline.com/00000000-0000-0000-0000-000000000000/oauth2/token",
+			dump: "HTTP/1.1 200 OK\r\n\r\n{\"token_type\":\"Bearer\",\"access_token\":\"abc123\",\"refresh_token\":\"abc123\"}",
+			contains: []string{
+				`"access_token":"**REDACTED**"`,
+				`"refresh_token":"**REDACTED**"`,
+			},
+			notContains: []string{"abc123"},
+		},
+		{
+			name: "Shared Access Signatures",
+			url:  "https://account1.blob.core.windows.net/container1/blob1?sv=2018-03-28&sig=abc123&se=2019-01-01",
+			dump: "PUT /container1/blob1?sv=2018-03-28&sig=abc123&se=2019-01-01 HTTP/1.1\r\n\r\n{\"sasUrl\":\"https://account1.blob.core.windows.net/container1?sv=2018-03-28\\u0026sig=abc123\\u0026se=2019-01-01\"}",
+			contains: []string{
+				"?sv=2018-03-28&sig=**REDACTED**&se=2019-01-01",
+				"\\u0026sig=**REDACTED**\\u0026se=2019-01-01",
+			},
+			notContains: []string{"abc123"},
+		},
+	}
+
+	for _, v := range testCases {
+		t.Run(v.name, func(t *testing.T) {
+			uri, err := url.Parse(v.url)
+			if err != nil {
+				t.Fatalf("Error parsing URL %q: %+v", v.url, err)
+			}
+
+			actual := redactDump(v.dump, uri)
+
+			for _, expected := range v.contains {
+				if !strings.Contains(actual, expected) {
+					t.Fatalf("Expected %q to contain %q", actual, expected)
+				}
+			}
+
+			for _, unexpected := range v.notContains {
+				if strings.Contains(actual, unexpected) {
+					t.Fatalf("Expected %q not to contain %q", actual, unexpected)
+				}
+			}
+		})
+	}
+}
+
+func TestIsSensitiveProperty(t *testing.T) {
+	testCases := []struct {
+		name         string
+		redactValues bool
+		expected     bool
+	}{
+		{name: "name", expected: false},
+		{name: "keyName", expected: false},
+		{name: "value", expected: false},
+		{name: "value", redactValues: true, expected: true},
+		{name: "password", expected: true},
+		{name: "adminPassword", expected: true},
+		{name: "client_secret", expected: true},
+		{name: "connectionString", expected: true},
+		{name: "primaryKey", expected: true},
+		{name: "primaryMasterKey", expected: true},
+		{name: "access_token", expected: true},
+		{name: "sasToken", expected: true},
+		{name: "sshPublicKey", expected: false},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q (redactValues %t)", v.name, v.redactValues)
+
+		if actual := isSensitiveProperty(v.name, v.redactValues); actual != v.expected {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
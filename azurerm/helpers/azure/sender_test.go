@@ -0,0 +1,406 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestWithRetries(t *testing.T) {
+	testCases := []struct {
+		name             string
+		method           string
+		responses        []int
+		errors           []error
+		maxRetries       int
+		expectedAttempts int
+		expectedStatus   int
+		expectError      bool
+		noRetryAfter     bool
+	}{
+		{
+			name:             "Success isn't retried",
+			method:           http.MethodGet,
+			responses:        []int{http.StatusOK},
+			maxRetries:       3,
+			expectedAttempts: 1,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "Not Found isn't retried",
+			method:           http.MethodGet,
+			responses:        []int{http.StatusNotFound},
+			maxRetries:       3,
+			expectedAttempts: 1,
+			expectedStatus:   http.StatusNotFound,
+		},
+		{
+			name:             "Throttled then Success",
+			method:           http.MethodPut,
+			responses:        []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			maxRetries:       3,
+			expectedAttempts: 3,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "Transient Server Errors then Success",
+			method:           http.MethodPut,
+			responses:        []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			maxRetries:       3,
+			expectedAttempts: 3,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "Throttled Non-Idempotent Request then Success",
+			method:           http.MethodPost,
+			responses:        []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries:       3,
+			expectedAttempts: 3,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "Server Error on a Non-Idempotent Request",
+			method:           http.MethodPost,
+			responses:        []int{http.StatusInternalServerError, http.StatusOK},
+			maxRetries:       3,
+			expectedAttempts: 1,
+			expectedStatus:   http.StatusInternalServerError,
+		},
+		{
+			name:             "Unavailable without Retry-After on a Non-Idempotent Request",
+			method:           http.MethodPost,
+			responses:        []int{http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries:       3,
+			expectedAttempts: 1,
+			expectedStatus:   http.StatusServiceUnavailable,
+			noRetryAfter:     true,
+		},
+		{
+			name:             "Gives up after Max Retries",
+			method:           http.MethodGet,
+			responses:        []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests},
+			maxRetries:       2,
+			expectedAttempts: 3,
+			expectedStatus:   http.StatusTooManyRequests,
+		},
+		{
+			name:             "Retries Disabled",
+			method:           http.MethodGet,
+			responses:        []int{http.StatusTooManyRequests, http.StatusOK},
+			maxRetries:       0,
+			expectedAttempts: 1,
+			expectedStatus:   http.StatusTooManyRequests,
+		},
+		{
+			name:             "Dropped Connection on an Idempotent Request",
+			method:           http.MethodDelete,
+			responses:        []int{0, http.StatusOK},
+			errors:           []error{io.ErrUnexpectedEOF, nil},
+			maxRetries:       3,
+			expectedAttempts: 2,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "Dropped Connection on a Non-Idempotent Request",
+			method:           http.MethodPost,
+			responses:        []int{0, http.StatusOK},
+			errors:           []error{io.ErrUnexpectedEOF, nil},
+			maxRetries:       3,
+			expectedAttempts: 1,
+			expectError:      true,
+		},
+		{
+			name:             "Non-Transient Error",
+			method:           http.MethodGet,
+			responses:        []int{0, http.StatusOK},
+			errors:           []error{fmt.Errorf("certificate signed by unknown authority"), nil},
+			maxRetries:       3,
+			expectedAttempts: 1,
+			expectError:      true,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Run(v.name, func(t *testing.T) {
+			attempts := 0
+			bodies := make([]string, 0)
+			sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+				body, _ := ioutil.ReadAll(r.Body)
+				bodies = append(bodies, string(body))
+
+				i := attempts
+				attempts++
+
+				if len(v.errors) > i && v.errors[i] != nil {
+					return nil, v.errors[i]
+				}
+
+				resp := &http.Response{
+					StatusCode: v.responses[i],
+					Status:     http.StatusText(v.responses[i]),
+					Header:     http.Header{},
+					Body:       ioutil.NopCloser(strings.NewReader("")),
+				}
+				if !v.noRetryAfter {
+					// avoid waiting on the backoff
+					resp.Header.Set("Retry-After", "0")
+				}
+				return resp, nil
+			})
+
+			req, _ := http.NewRequest(v.method, "https://management.azure.com/subscriptions", strings.NewReader("hello"))
+			resp, err := withRetries(v.maxRetries, time.Millisecond)(sender).Do(req)
+
+			if attempts != v.expectedAttempts {
+				t.Fatalf("Expected %d attempts but got %d", v.expectedAttempts, attempts)
+			}
+
+			for i, body := range bodies {
+				if body != "hello" {
+					t.Fatalf("Expected the body to be re-sent for attempt %d but got %q", i+1, body)
+				}
+			}
+
+			if v.expectError {
+				if err == nil {
+					t.Fatalf("Expected an error but didn't get one")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if resp.StatusCode != v.expectedStatus {
+				t.Fatalf("Expected Status Code %d but got %d", v.expectedStatus, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestSenderIsTheOnlyRetryPolicy(t *testing.T) {
+	testCases := []struct {
+		name             string
+		statusCode       int
+		maxRetries       int
+		expectedRequests int32
+	}{
+		{
+			name:             "Server Error",
+			statusCode:       http.StatusInternalServerError,
+			maxRetries:       2,
+			expectedRequests: 3,
+		},
+		{
+			name:             "Server Error with Retries Disabled",
+			statusCode:       http.StatusInternalServerError,
+			maxRetries:       0,
+			expectedRequests: 1,
+		},
+		{
+			name:             "Throttled",
+			statusCode:       http.StatusTooManyRequests,
+			maxRetries:       2,
+			expectedRequests: 3,
+		},
+		{
+			name:             "Throttled with Retries Disabled",
+			statusCode:       http.StatusTooManyRequests,
+			maxRetries:       0,
+			expectedRequests: 1,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Run(v.name, func(t *testing.T) {
+			var requests int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&requests, 1)
+				// this is capped at the `MaxRetryDelay` below
+				w.Header().Set("Retry-After", "3600")
+				w.WriteHeader(v.statusCode)
+			}))
+			defer server.Close()
+
+			client := resources.NewGroupsClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+			client.Sender = BuildSender(SenderOptions{
+				MaxRetries:    v.maxRetries,
+				MaxRetryDelay: time.Millisecond,
+			})
+			DisableClientRetries(&client.Client)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			if _, err := client.Get(ctx, "example-resources"); err == nil {
+				t.Fatalf("Expected an error but didn't get one")
+			}
+
+			if actual := atomic.LoadInt32(&requests); actual != v.expectedRequests {
+				t.Fatalf("Expected %d requests but got %d", v.expectedRequests, actual)
+			}
+		})
+	}
+}
+
+func TestSenderRetriesAfterRegisteringResourceProvider(t *testing.T) {
+	var requests, registrations int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/providers/Microsoft.Resources/register"):
+			atomic.AddInt32(&registrations, 1)
+			fmt.Fprint(w, `{"registrationState": "Registering"}`)
+		case strings.HasSuffix(r.URL.Path, "/providers/Microsoft.Resources"):
+			fmt.Fprint(w, `{"registrationState": "Registered"}`)
+		case atomic.AddInt32(&requests, 1) == 1:
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"error": {"code": "MissingSubscriptionRegistration", "message": "The subscription is not registered", "details": [{"target": "Microsoft.Resources"}]}}`)
+		default:
+			fmt.Fprint(w, `{"name": "example-resources"}`)
+		}
+	}))
+	defer server.Close()
+
+	client := resources.NewGroupsClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+	client.Sender = BuildSender(SenderOptions{
+		MaxRetries:    2,
+		MaxRetryDelay: time.Millisecond,
+	})
+	DisableClientRetries(&client.Client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := client.Get(ctx, "example-resources"); err != nil {
+		t.Fatalf("Expected the request to succeed once the Resource Provider was registered but got: %+v", err)
+	}
+
+	if actual := atomic.LoadInt32(&registrations); actual != 1 {
+		t.Fatalf("Expected the Resource Provider to be registered once but got %d", actual)
+	}
+
+	if actual := atomic.LoadInt32(&requests); actual != 2 {
+		t.Fatalf("Expected the request to be re-sent after registering the Resource Provider, but got %d requests", actual)
+	}
+
+	if len(autorest.StatusCodesForRetry) == 0 {
+		t.Fatalf("Expected the SDK's Status Codes for Retry to be left as-is")
+	}
+}
+
+func TestWithRetriesHonoursContextCancellation(t *testing.T) {
+	attempts := 0
+	sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		attempts++
+		resp := &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(strings.NewReader("")),
+		}
+		resp.Header.Set("Retry-After", "3600")
+		return resp, nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions", nil)
+	_, err := withRetries(5, time.Second)(sender).Do(req.WithContext(ctx))
+	if err != context.DeadlineExceeded {
+		t.Fatalf("Expected %q but got %+v", context.DeadlineExceeded, err)
+	}
+
+	if attempts != 1 {
+		t.Fatalf("Expected 1 attempt but got %d", attempts)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected time.Duration
+		valid    bool
+	}{
+		{
+			input: "",
+			valid: false,
+		},
+		{
+			input: "not-a-delay",
+			valid: false,
+		},
+		{
+			input: "-5",
+			valid: false,
+		},
+		{
+			input:    "0",
+			expected: 0,
+			valid:    true,
+		},
+		{
+			input:    "17",
+			expected: 17 * time.Second,
+			valid:    true,
+		},
+		{
+			input:    "Wed, 21 Oct 2015 07:28:00 GMT",
+			expected: 0,
+			valid:    true,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		actual, valid := parseRetryAfter(v.input)
+		if valid != v.valid {
+			t.Fatalf("Expected valid to be %t but got %t", v.valid, valid)
+		}
+
+		if actual != v.expected {
+			t.Fatalf("Expected %s but got %s", v.expected, actual)
+		}
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	maxDelay := 30 * time.Second
+
+	for attempt := 0; attempt < 64; attempt++ {
+		delay := retryDelay(nil, attempt, maxDelay)
+		if delay > maxDelay {
+			t.Fatalf("Expected the delay for attempt %d to be at most %s but got %s", attempt, maxDelay, delay)
+		}
+
+		if delay < retryMinDelay/2 {
+			t.Fatalf("Expected the delay for attempt %d to be at least %s but got %s", attempt, retryMinDelay/2, delay)
+		}
+	}
+
+	resp := &http.Response{
+		Header: http.Header{},
+	}
+	resp.Header.Set("Retry-After", "20")
+	if delay := retryDelay(resp, 0, maxDelay); delay != 20*time.Second {
+		t.Fatalf("Expected the `Retry-After` header to be honoured but got %s", delay)
+	}
+
+	resp.Header.Set("Retry-After", "90")
+	if delay := retryDelay(resp, 0, maxDelay); delay != maxDelay {
+		t.Fatalf("Expected the `Retry-After` header to be capped at %s but got %s", maxDelay, delay)
+	}
+}
@@ -2,8 +2,43 @@ package azure
 
 import (
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 )
 
+// subscriptionIdOverrideDescription is the Description of the `subscription_id` field returned by
+// `SchemaSubscriptionIdOverride`, which is used to identify the resources supporting this override
+const subscriptionIdOverrideDescription = "The ID of the Subscription in which this resource should be managed, if it's not the one the Provider is configured for."
+
+// SchemaSubscriptionIdOverride returns the Schema for the optional `subscription_id` field, which allows
+// a resource to be managed within a Subscription other than the one the Provider is configured for
+func SchemaSubscriptionIdOverride() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: validate.UUID,
+		Description:  subscriptionIdOverrideDescription,
+	}
+}
+
+// IsSchemaSubscriptionIdOverride determines whether the specified Schema was returned by `SchemaSubscriptionIdOverride`,
+// since other resources (such as `azurerm_api_management_subscription`) use a `subscription_id` field for other purposes
+func IsSchemaSubscriptionIdOverride(s *schema.Schema) bool {
+	return s != nil && s.Description == subscriptionIdOverrideDescription
+}
+
+// SchemaSubscriptionIdOverrideForDataSource returns the Schema for the optional `subscription_id` field,
+// which allows a data source to look up a resource within another Subscription
+func SchemaSubscriptionIdOverrideForDataSource() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validate.UUID,
+	}
+}
+
 func SchemaSubscription(subscriptionIDOptional bool) map[string]*schema.Schema {
 	s := map[string]*schema.Schema{
 		"subscription_id": {
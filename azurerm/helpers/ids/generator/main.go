@@ -0,0 +1,503 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// resourceType defines a Resource Type managed by the Provider, from which a typed Resource ID is generated
+type resourceType struct {
+	// name is the name of the Resource Type, used as the prefix of the generated type (e.g. `VirtualNetwork`)
+	name string
+
+	// description is the human-friendly name of the Resource Type, used in documentation and error messages
+	description string
+
+	// template is the format of the Resource ID, where user-specified values are `{placeholders}` - these
+	// become fields on the generated type, where the final segment must be `{name}`
+	template string
+}
+
+var resourceTypes = []resourceType{
+	{"ApiManagementApi", "API Management API", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/apis/{name}"},
+	{"ApiManagementApiOperation", "API Management API Operation", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/apis/{apiName}/operations/{name}"},
+	{"ApiManagementApiVersionSet", "API Management API Version Set", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/api-version-sets/{name}"},
+	{"ApiManagementAuthorizationServer", "API Management Authorization Server", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/authorizationServers/{name}"},
+	{"ApiManagementCertificate", "API Management Certificate", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/certificates/{name}"},
+	{"ApiManagementGroup", "API Management Group", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/groups/{name}"},
+	{"ApiManagementGroupUser", "API Management Group User", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/groups/{groupName}/users/{name}"},
+	{"ApiManagementLogger", "API Management Logger", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/loggers/{name}"},
+	{"ApiManagementOpenIDConnectProvider", "API Management OpenID Connect Provider", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/openidConnectProviders/{name}"},
+	{"ApiManagementProduct", "API Management Product", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/products/{name}"},
+	{"ApiManagementProductApi", "API Management Product API", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/products/{productName}/apis/{name}"},
+	{"ApiManagementProductGroup", "API Management Product Group", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/products/{productName}/groups/{name}"},
+	{"ApiManagementProperty", "API Management Property", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/properties/{name}"},
+	{"ApiManagementService", "API Management Service", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{name}"},
+	{"ApiManagementSubscription", "API Management Subscription", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/subscriptions/{name}"},
+	{"ApiManagementUser", "API Management User", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/users/{name}"},
+	{"AppService", "App Service", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{name}"},
+	{"AppServiceCustomHostnameBinding", "App Service Custom Hostname Binding", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{siteName}/hostNameBindings/{name}"},
+	{"AppServiceEnvironment", "App Service Environment", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/hostingEnvironments/{name}"},
+	{"AppServicePlan", "App Service Plan", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/serverfarms/{name}"},
+	{"AppServiceSlot", "App Service Slot", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{siteName}/slots/{name}"},
+	{"ApplicationGateway", "Application Gateway", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/applicationGateways/{name}"},
+	{"ApplicationGatewayBackendAddressPool", "Application Gateway Backend Address Pool", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/applicationGateways/{applicationGatewayName}/backendAddressPools/{name}"},
+	{"ApplicationInsights", "Application Insights", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/components/{name}"},
+	{"ApplicationInsightsApiKey", "Application Insights API Key", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/components/{componentName}/apikeys/{name}"},
+	{"ApplicationSecurityGroup", "Application Security Group", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/applicationSecurityGroups/{name}"},
+	{"AutomationAccount", "Automation Account", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{name}"},
+	{"AutomationCredential", "Automation Credential", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccountName}/credentials/{name}"},
+	{"AutomationDscConfiguration", "Automation DSC Configuration", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccountName}/configurations/{name}"},
+	{"AutomationDscNodeConfiguration", "Automation DSC Node Configuration", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccountName}/nodeConfigurations/{name}"},
+	{"AutomationModule", "Automation Module", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccountName}/modules/{name}"},
+	{"AutomationRunbook", "Automation Runbook", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccountName}/runbooks/{name}"},
+	{"AutomationSchedule", "Automation Schedule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccountName}/schedules/{name}"},
+	{"AvailabilitySet", "Availability Set", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/availabilitySets/{name}"},
+	{"BatchAccount", "Batch Account", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Batch/batchAccounts/{name}"},
+	{"BatchCertificate", "Batch Certificate", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Batch/batchAccounts/{batchAccountName}/certificates/{name}"},
+	{"BatchPool", "Batch Pool", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Batch/batchAccounts/{batchAccountName}/pools/{name}"},
+	{"CdnEndpoint", "CDN Endpoint", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cdn/profiles/{profileName}/endpoints/{name}"},
+	{"CdnProfile", "CDN Profile", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cdn/profiles/{name}"},
+	{"ClassicVirtualNetwork", "Classic Virtual Network", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ClassicNetwork/virtualNetworks/{name}"},
+	{"CognitiveAccount", "Cognitive Services Account", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.CognitiveServices/accounts/{name}"},
+	{"ContainerGroup", "Container Group", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerInstance/containerGroups/{name}"},
+	{"ContainerRegistry", "Container Registry", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerRegistry/registries/{name}"},
+	{"ContainerService", "Container Service", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerService/containerServices/{name}"},
+	{"CosmosDBAccount", "CosmosDB Account", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{name}"},
+	{"DataFactory", "Data Factory", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataFactory/factories/{name}"},
+	{"DataFactoryDataset", "Data Factory Dataset", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataFactory/factories/{factoryName}/datasets/{name}"},
+	{"DataFactoryLinkedService", "Data Factory Linked Service", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataFactory/factories/{factoryName}/linkedservices/{name}"},
+	{"DataFactoryPipeline", "Data Factory Pipeline", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataFactory/factories/{factoryName}/pipelines/{name}"},
+	{"DataLakeAnalyticsAccount", "Data Lake Analytics Account", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeAnalytics/accounts/{name}"},
+	{"DataLakeAnalyticsFirewallRule", "Data Lake Analytics Firewall Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeAnalytics/accounts/{accountName}/firewallRules/{name}"},
+	{"DataLakeStore", "Data Lake Store", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeStore/accounts/{name}"},
+	{"DataLakeStoreFirewallRule", "Data Lake Store Firewall Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeStore/accounts/{accountName}/firewallRules/{name}"},
+	{"DatabricksWorkspace", "Databricks Workspace", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Databricks/workspaces/{name}"},
+	{"DevSpaceController", "DevSpace Controller", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DevSpaces/controllers/{name}"},
+	{"DevTestLab", "Dev Test Lab", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DevTestLab/labs/{name}"},
+	{"DevTestPolicy", "Dev Test Policy", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DevTestLab/labs/{labName}/policysets/{policySetName}/policies/{name}"},
+	{"DevTestVirtualMachine", "Dev Test Virtual Machine", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DevTestLab/labs/{labName}/virtualmachines/{name}"},
+	{"DevTestVirtualNetwork", "Dev Test Virtual Network", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DevTestLab/labs/{labName}/virtualnetworks/{name}"},
+	{"DnsAAAARecord", "DNS AAAA Record", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{zoneName}/AAAA/{name}"},
+	{"DnsARecord", "DNS A Record", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{zoneName}/A/{name}"},
+	{"DnsCNameRecord", "DNS CNAME Record", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{zoneName}/CNAME/{name}"},
+	{"DnsCaaRecord", "DNS CAA Record", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{zoneName}/CAA/{name}"},
+	{"DnsMxRecord", "DNS MX Record", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{zoneName}/MX/{name}"},
+	{"DnsNsRecord", "DNS NS Record", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{zoneName}/NS/{name}"},
+	{"DnsPtrRecord", "DNS PTR Record", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{zoneName}/PTR/{name}"},
+	{"DnsSrvRecord", "DNS SRV Record", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{zoneName}/SRV/{name}"},
+	{"DnsTxtRecord", "DNS TXT Record", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{zoneName}/TXT/{name}"},
+	{"DnsZone", "DNS Zone", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{name}"},
+	{"EventGridDomain", "EventGrid Domain", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventGrid/domains/{name}"},
+	{"EventGridTopic", "EventGrid Topic", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventGrid/topics/{name}"},
+	{"EventHub", "EventHub", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespaceName}/eventhubs/{name}"},
+	{"EventHubAuthorizationRule", "EventHub Authorization Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespaceName}/eventhubs/{eventhubName}/authorizationRules/{name}"},
+	{"EventHubConsumerGroup", "EventHub Consumer Group", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespaceName}/eventhubs/{eventhubName}/consumergroups/{name}"},
+	{"EventHubNamespace", "EventHub Namespace", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{name}"},
+	{"EventHubNamespaceAuthorizationRule", "EventHub Namespace Authorization Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespaceName}/authorizationRules/{name}"},
+	{"ExpressRouteCircuit", "ExpressRoute Circuit", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{name}"},
+	{"ExpressRouteCircuitAuthorization", "ExpressRoute Circuit Authorization", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{expressRouteCircuitName}/authorizations/{name}"},
+	{"ExpressRouteCircuitPeering", "ExpressRoute Circuit Peering", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{expressRouteCircuitName}/peerings/{name}"},
+	{"ExpressRouteConnection", "ExpressRoute Connection", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteGateways/{expressRouteGatewayName}/expressRouteConnections/{name}"},
+	{"ExpressRouteGateway", "ExpressRoute Gateway", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteGateways/{name}"},
+	{"ExpressRoutePort", "ExpressRoute Port", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRoutePorts/{name}"},
+	{"Firewall", "Firewall", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/azureFirewalls/{name}"},
+	{"FirewallApplicationRuleCollection", "Firewall Application Rule Collection", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/azureFirewalls/{azureFirewallName}/applicationRuleCollections/{name}"},
+	{"FirewallNetworkRuleCollection", "Firewall Network Rule Collection", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/azureFirewalls/{azureFirewallName}/networkRuleCollections/{name}"},
+	{"HDInsightCluster", "HDInsight Cluster", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.HDInsight/clusters/{name}"},
+	{"Image", "Image", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/images/{name}"},
+	{"InterfaceEndpoint", "Interface Endpoint", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/interfaceEndpoints/{name}"},
+	{"IotHub", "IoTHub", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Devices/IotHubs/{name}"},
+	{"IotHubConsumerGroup", "IoTHub Consumer Group", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Devices/IotHubs/{iotHubName}/eventHubEndpoints/{eventHubEndpointName}/ConsumerGroups/{name}"},
+	{"KeyVault", "Key Vault", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.KeyVault/vaults/{name}"},
+	{"KubernetesCluster", "Kubernetes Cluster", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerService/managedClusters/{name}"},
+	{"LoadBalancer", "Load Balancer", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{name}"},
+	{"LoadBalancerBackendAddressPool", "Load Balancer Backend Address Pool", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/backendAddressPools/{name}"},
+	{"LoadBalancerFrontendIPConfiguration", "Load Balancer Frontend IP Configuration", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/frontendIPConfigurations/{name}"},
+	{"LoadBalancerInboundNatPool", "Load Balancer Inbound NAT Pool", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/inboundNatPools/{name}"},
+	{"LoadBalancerInboundNatRule", "Load Balancer Inbound NAT Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/inboundNatRules/{name}"},
+	{"LoadBalancerOutboundRule", "Load Balancer Outbound Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/outboundRules/{name}"},
+	{"LoadBalancerProbe", "Load Balancer Probe", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/probes/{name}"},
+	{"LoadBalancerRule", "Load Balancer Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/loadBalancingRules/{name}"},
+	{"LocalNetworkGateway", "Local Network Gateway", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/localNetworkGateways/{name}"},
+	{"LogAnalyticsLinkedService", "Log Analytics Linked Service", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{workspaceName}/linkedservices/{name}"},
+	{"LogAnalyticsSolution", "Log Analytics Solution", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationsManagement/solutions/{name}"},
+	{"LogAnalyticsWorkspace", "Log Analytics Workspace", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{name}"},
+	{"LogicAppWorkflow", "Logic App Workflow", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Logic/workflows/{name}"},
+	{"ManagedDisk", "Managed Disk", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/disks/{name}"},
+	{"ManagementGroup", "Management Group", "/providers/Microsoft.Management/managementGroups/{name}"},
+	{"MariaDBDatabase", "MariaDB Database", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMariaDB/servers/{serverName}/databases/{name}"},
+	{"MariaDBServer", "MariaDB Server", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMariaDB/servers/{name}"},
+	{"MediaServicesAccount", "Media Services Account", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Media/mediaservices/{name}"},
+	{"MonitorActionGroup", "Monitor Action Group", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/actionGroups/{name}"},
+	{"MonitorActivityLogAlert", "Monitor Activity Log Alert", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/activityLogAlerts/{name}"},
+	{"MonitorAutoscaleSetting", "Monitor Autoscale Setting", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/autoscalesettings/{name}"},
+	{"MonitorMetricAlert", "Monitor Metric Alert", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/metricAlerts/{name}"},
+	{"MonitorMetricAlertRule", "Monitor Metric Alert Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/alertrules/{name}"},
+	{"MsSqlElasticPool", "MS SQL Elastic Pool", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/elasticPools/{name}"},
+	{"MySQLConfiguration", "MySQL Configuration", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{serverName}/configurations/{name}"},
+	{"MySQLDatabase", "MySQL Database", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{serverName}/databases/{name}"},
+	{"MySQLFirewallRule", "MySQL Firewall Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{serverName}/firewallRules/{name}"},
+	{"MySQLServer", "MySQL Server", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{name}"},
+	{"MySQLVirtualNetworkRule", "MySQL Virtual Network Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{serverName}/virtualNetworkRules/{name}"},
+	{"NetworkConnectionMonitor", "Network Connection Monitor", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkWatchers/{networkWatcherName}/connectionMonitors/{name}"},
+	{"NetworkDDoSCustomPolicy", "Network DDoS Custom Policy", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/ddosCustomPolicies/{name}"},
+	{"NetworkDDoSProtectionPlan", "Network DDoS Protection Plan", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/ddosProtectionPlans/{name}"},
+	{"NetworkInterface", "Network Interface", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkInterfaces/{name}"},
+	{"NetworkInterfaceIPConfiguration", "Network Interface IP Configuration", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkInterfaces/{networkInterfaceName}/ipConfigurations/{name}"},
+	{"NetworkInterfaceTapConfiguration", "Network Interface TAP Configuration", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkInterfaces/{networkInterfaceName}/tapConfigurations/{name}"},
+	{"NetworkPacketCapture", "Network Packet Capture", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkWatchers/{networkWatcherName}/packetCaptures/{name}"},
+	{"NetworkProfile", "Network Profile", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkProfiles/{name}"},
+	{"NetworkSecurityGroup", "Network Security Group", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkSecurityGroups/{name}"},
+	{"NetworkSecurityRule", "Network Security Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkSecurityGroups/{networkSecurityGroupName}/securityRules/{name}"},
+	{"NetworkWatcher", "Network Watcher", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkWatchers/{name}"},
+	{"NotificationHub", "Notification Hub", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{namespaceName}/notificationHubs/{name}"},
+	{"NotificationHubAuthorizationRule", "Notification Hub Authorization Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{namespaceName}/notificationHubs/{notificationHubName}/AuthorizationRules/{name}"},
+	{"NotificationHubNamespace", "Notification Hub Namespace", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{name}"},
+	{"PointToSiteVpnGateway", "Point-to-Site VPN Gateway", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/p2sVpnGateways/{name}"},
+	{"PointToSiteVpnServerConfiguration", "Point-to-Site VPN Server Configuration", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualWans/{virtualWanName}/p2sVpnServerConfigurations/{name}"},
+	{"PostgreSQLConfiguration", "PostgreSQL Configuration", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/configurations/{name}"},
+	{"PostgreSQLDatabase", "PostgreSQL Database", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/databases/{name}"},
+	{"PostgreSQLFirewallRule", "PostgreSQL Firewall Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/firewallRules/{name}"},
+	{"PostgreSQLServer", "PostgreSQL Server", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{name}"},
+	{"PostgreSQLVirtualNetworkRule", "PostgreSQL Virtual Network Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/virtualNetworkRules/{name}"},
+	{"PublicIPAddress", "Public IP Address", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/publicIPAddresses/{name}"},
+	{"PublicIPPrefix", "Public IP Prefix", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/publicIPPrefixes/{name}"},
+	{"RecoveryServicesProtectionPolicy", "Recovery Services Protection Policy", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.RecoveryServices/vaults/{vaultName}/backupPolicies/{name}"},
+	{"RecoveryServicesVault", "Recovery Services Vault", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.RecoveryServices/vaults/{name}"},
+	{"RedisCache", "Redis Cache", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cache/Redis/{name}"},
+	{"RedisFirewallRule", "Redis Firewall Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cache/Redis/{cacheName}/firewallRules/{name}"},
+	{"RelayHybridConnection", "Relay Hybrid Connection", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Relay/namespaces/{namespaceName}/hybridConnections/{name}"},
+	{"RelayNamespace", "Relay Namespace", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Relay/namespaces/{name}"},
+	{"ResourceGroup", "Resource Group", "/subscriptions/{subscriptionId}/resourceGroups/{name}"},
+	{"Route", "Route", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeTables/{routeTableName}/routes/{name}"},
+	{"RouteFilter", "Route Filter", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeFilters/{name}"},
+	{"RouteFilterRule", "Route Filter Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeFilters/{routeFilterName}/routeFilterRules/{name}"},
+	{"RouteTable", "Route Table", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeTables/{name}"},
+	{"SchedulerJob", "Scheduler Job", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Scheduler/jobCollections/{jobCollectionName}/jobs/{name}"},
+	{"SchedulerJobCollection", "Scheduler Job Collection", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Scheduler/jobCollections/{name}"},
+	{"SearchService", "Search Service", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Search/searchServices/{name}"},
+	{"ServiceBusNamespace", "ServiceBus Namespace", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{name}"},
+	{"ServiceBusNamespaceAuthorizationRule", "ServiceBus Namespace Authorization Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/AuthorizationRules/{name}"},
+	{"ServiceBusQueue", "ServiceBus Queue", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/queues/{name}"},
+	{"ServiceBusQueueAuthorizationRule", "ServiceBus Queue Authorization Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/queues/{queueName}/authorizationRules/{name}"},
+	{"ServiceBusSubscription", "ServiceBus Subscription", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{topicName}/subscriptions/{name}"},
+	{"ServiceBusSubscriptionRule", "ServiceBus Subscription Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{topicName}/subscriptions/{subscriptionName}/rules/{name}"},
+	{"ServiceBusTopic", "ServiceBus Topic", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{name}"},
+	{"ServiceBusTopicAuthorizationRule", "ServiceBus Topic Authorization Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{topicName}/authorizationRules/{name}"},
+	{"ServiceFabricCluster", "Service Fabric Cluster", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceFabric/clusters/{name}"},
+	{"SharedImage", "Shared Image", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{galleryName}/images/{name}"},
+	{"SharedImageGallery", "Shared Image Gallery", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{name}"},
+	{"SharedImageVersion", "Shared Image Version", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{galleryName}/images/{imageName}/versions/{name}"},
+	{"SignalRService", "SignalR Service", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.SignalRService/SignalR/{name}"},
+	{"Snapshot", "Snapshot", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/snapshots/{name}"},
+	{"SqlDatabase", "SQL Database", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/databases/{name}"},
+	{"SqlElasticPool", "SQL Elastic Pool", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/elasticPools/{name}"},
+	{"SqlFirewallRule", "SQL Firewall Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/firewallRules/{name}"},
+	{"SqlServer", "SQL Server", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{name}"},
+	{"SqlVirtualNetworkRule", "SQL Virtual Network Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/virtualNetworkRules/{name}"},
+	{"StorageAccount", "Storage Account", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Storage/storageAccounts/{name}"},
+	{"StreamAnalyticsFunction", "Stream Analytics Function", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.StreamAnalytics/streamingjobs/{streamingJobName}/functions/{name}"},
+	{"StreamAnalyticsInput", "Stream Analytics Input", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.StreamAnalytics/streamingjobs/{streamingJobName}/inputs/{name}"},
+	{"StreamAnalyticsJob", "Stream Analytics Job", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.StreamAnalytics/streamingjobs/{name}"},
+	{"StreamAnalyticsOutput", "Stream Analytics Output", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.StreamAnalytics/streamingjobs/{streamingJobName}/outputs/{name}"},
+	{"Subnet", "Subnet", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{virtualNetworkName}/subnets/{name}"},
+	{"SubnetServiceEndpointStoragePolicy", "Subnet Service Endpoint Storage Policy", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/serviceEndpointPolicies/{name}"},
+	{"TemplateDeployment", "Template Deployment", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Resources/deployments/{name}"},
+	{"TrafficManagerProfile", "Traffic Manager Profile", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/trafficManagerProfiles/{name}"},
+	{"UserAssignedIdentity", "User Assigned Identity", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ManagedIdentity/userAssignedIdentities/{name}"},
+	{"VirtualHub", "Virtual Hub", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualHubs/{name}"},
+	{"VirtualHubConnection", "Virtual Hub Connection", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualHubs/{virtualHubName}/hubVirtualNetworkConnections/{name}"},
+	{"VirtualMachine", "Virtual Machine", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{name}"},
+	{"VirtualMachineExtension", "Virtual Machine Extension", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{virtualMachineName}/extensions/{name}"},
+	{"VirtualMachineScaleSet", "Virtual Machine Scale Set", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachineScaleSets/{name}"},
+	{"VirtualNetwork", "Virtual Network", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{name}"},
+	{"VirtualNetworkGateway", "Virtual Network Gateway", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworkGateways/{name}"},
+	{"VirtualNetworkGatewayConnection", "Virtual Network Gateway Connection", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/connections/{name}"},
+	{"VirtualNetworkPeering", "Virtual Network Peering", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{virtualNetworkName}/virtualNetworkPeerings/{name}"},
+	{"VirtualNetworkTap", "Virtual Network TAP", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworkTaps/{name}"},
+	{"VirtualWan", "Virtual WAN", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualWans/{name}"},
+	{"VpnGateway", "VPN Gateway", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/vpnGateways/{name}"},
+	{"VpnGatewayConnection", "VPN Gateway Connection", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/vpnGateways/{vpnGatewayName}/vpnConnections/{name}"},
+	{"VpnSite", "VPN Site", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/vpnSites/{name}"},
+	{"WebApplicationFirewallPolicy", "Web Application Firewall Policy", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/ApplicationGatewayWebApplicationFirewallPolicies/{name}"},
+}
+
+func main() {
+	output := flag.String("output", "ids_gen.go", "The path to write the generated Resource IDs to")
+	testOutput := flag.String("test-output", "ids_gen_test.go", "The path to write the generated tests to")
+	flag.Parse()
+
+	code, tests, err := generate(resourceTypes)
+	if err != nil {
+		log.Fatalf("Error generating Resource IDs: %+v", err)
+	}
+
+	if err := ioutil.WriteFile(*output, code, 0644); err != nil {
+		log.Fatalf("Error writing %q: %+v", *output, err)
+	}
+
+	if err := ioutil.WriteFile(*testOutput, tests, 0644); err != nil {
+		log.Fatalf("Error writing %q: %+v", *testOutput, err)
+	}
+}
+
+type field struct {
+	Name        string
+	Placeholder string
+	TestValue   string
+}
+
+type generatedType struct {
+	Name        string
+	VarName     string
+	Description string
+	Article     string
+	Template    string
+	Fields      []field
+	TestInput   string
+}
+
+func generate(input []resourceType) ([]byte, []byte, error) {
+	types := make([]generatedType, 0)
+	names := make(map[string]bool)
+
+	for _, v := range input {
+		if names[v.name] {
+			return nil, nil, fmt.Errorf("Resource Type %q is defined multiple times", v.name)
+		}
+		names[v.name] = true
+
+		t, err := buildGeneratedType(v)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		types = append(types, *t)
+	}
+
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].Name < types[j].Name
+	})
+
+	code, err := render(codeTemplate, types)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error rendering code: %+v", err)
+	}
+
+	tests, err := render(testTemplate, types)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error rendering tests: %+v", err)
+	}
+
+	return code, tests, nil
+}
+
+func buildGeneratedType(input resourceType) (*generatedType, error) {
+	if !strings.HasPrefix(input.template, "/") {
+		return nil, fmt.Errorf("The template for %q must begin with a `/`", input.name)
+	}
+
+	components := strings.Split(strings.TrimPrefix(input.template, "/"), "/")
+	if len(components)%2 != 0 {
+		return nil, fmt.Errorf("The template for %q must contain key-value pairs", input.name)
+	}
+
+	fields := make([]field, 0)
+	testInput := make([]string, 0)
+	for i := 0; i < len(components); i += 2 {
+		key := components[i]
+		value := components[i+1]
+		if key == "" || value == "" {
+			return nil, fmt.Errorf("The template for %q contains an empty segment", input.name)
+		}
+
+		if !strings.HasPrefix(value, "{") || !strings.HasSuffix(value, "}") {
+			testInput = append(testInput, key, value)
+			continue
+		}
+
+		placeholder := strings.TrimSuffix(strings.TrimPrefix(value, "{"), "}")
+		f := field{
+			Name:        exportedName(placeholder),
+			Placeholder: placeholder,
+			TestValue:   placeholder + "1",
+		}
+		if placeholder == "subscriptionId" {
+			f.TestValue = "00000000-0000-0000-0000-000000000000"
+		}
+
+		fields = append(fields, f)
+		testInput = append(testInput, key, f.TestValue)
+	}
+
+	if len(fields) == 0 || fields[len(fields)-1].Placeholder != "name" {
+		return nil, fmt.Errorf("The template for %q must end with a `{name}` segment", input.name)
+	}
+
+	article := "a"
+	if strings.ContainsAny(input.description[:1], "AEIO") || strings.HasPrefix(input.description, "HDInsight") || strings.HasPrefix(input.description, "MS ") {
+		article = "an"
+	}
+
+	return &generatedType{
+		Name:        input.name,
+		VarName:     unexportedName(input.name),
+		Description: input.description,
+		Article:     article,
+		Template:    input.template,
+		Fields:      fields,
+		TestInput:   "/" + strings.Join(testInput, "/"),
+	}, nil
+}
+
+// exportedName converts a placeholder (e.g. `subscriptionId`) into the name of a field (e.g. `SubscriptionID`)
+func exportedName(input string) string {
+	output := strings.ToUpper(input[:1]) + input[1:]
+	if strings.HasSuffix(output, "Id") {
+		output = strings.TrimSuffix(output, "Id") + "ID"
+	}
+	return output
+}
+
+// unexportedName converts the name of a Resource Type (e.g. `HDInsightCluster`) into an unexported
+// identifier (e.g. `hdInsightCluster`)
+func unexportedName(input string) string {
+	runes := []rune(input)
+	for i := range runes {
+		if runes[i] < 'A' || runes[i] > 'Z' {
+			break
+		}
+
+		// the last upper-case character before a lower-case character starts the next word
+		if i > 0 && i+1 < len(runes) && runes[i+1] >= 'a' && runes[i+1] <= 'z' {
+			break
+		}
+
+		runes[i] = runes[i] + ('a' - 'A')
+	}
+	return string(runes)
+}
+
+func render(tmpl string, types []generatedType) ([]byte, error) {
+	t, err := template.New("").Funcs(template.FuncMap{
+		"last": func(i int, fields []field) bool {
+			return i == len(fields)-1
+		},
+	}).Parse(tmpl)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, types); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+const codeTemplate = `// Code generated by ./generator; DO NOT EDIT.
+
+package ids
+{{ range $t := . }}
+var {{ $t.VarName }}IDFormat = newResourceIDFormat("{{ $t.Description }}", "{{ $t.Template }}")
+
+// {{ $t.Name }}ID is the Resource ID of {{ $t.Article }} {{ $t.Description }}
+type {{ $t.Name }}ID struct {
+{{- range $t.Fields }}
+	{{ .Name }} string
+{{- end }}
+}
+
+// New{{ $t.Name }}ID returns the Resource ID of {{ $t.Article }} {{ $t.Description }}
+func New{{ $t.Name }}ID({{ range $i, $f := $t.Fields }}{{ $f.Placeholder }}{{ if not (last $i $t.Fields) }}, {{ end }}{{ end }} string) {{ $t.Name }}ID {
+	return {{ $t.Name }}ID{
+{{- range $t.Fields }}
+		{{ .Name }}: {{ .Placeholder }},
+{{- end }}
+	}
+}
+
+// Parse{{ $t.Name }}ID parses the specified Resource ID as the ID of {{ $t.Article }} {{ $t.Description }}
+func Parse{{ $t.Name }}ID(input string) (*{{ $t.Name }}ID, error) {
+	values, err := {{ $t.VarName }}IDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &{{ $t.Name }}ID{
+{{- range $i, $f := $t.Fields }}
+		{{ $f.Name }}: values[{{ $i }}],
+{{- end }}
+	}, nil
+}
+
+// String returns the Resource ID of this {{ $t.Description }}
+func (id {{ $t.Name }}ID) String() string {
+	return {{ $t.VarName }}IDFormat.id({{ range $i, $f := $t.Fields }}id.{{ $f.Name }}{{ if not (last $i $t.Fields) }}, {{ end }}{{ end }})
+}
+
+// Validate{{ $t.Name }}ID validates that the specified value is the Resource ID of {{ $t.Article }} {{ $t.Description }}
+func Validate{{ $t.Name }}ID(i interface{}, k string) (warnings []string, errors []error) {
+	return {{ $t.VarName }}IDFormat.validate(i, k)
+}
+{{ end }}`
+
+const testTemplate = `// Code generated by ./generator; DO NOT EDIT.
+
+package ids
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestGeneratedResourceIDs(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Parse    func(input string) (fmt.Stringer, error)
+		Validate schema.SchemaValidateFunc
+	}{
+{{- range . }}
+		{
+			Name:     "{{ .Name }}",
+			Input:    "{{ .TestInput }}",
+			Parse:    func(input string) (fmt.Stringer, error) { return Parse{{ .Name }}ID(input) },
+			Validate: Validate{{ .Name }}ID,
+		},
+{{- end }}
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		id, err := v.Parse(v.Input)
+		if err != nil {
+			t.Fatalf("Expected no error parsing %q but got: %+v", v.Input, err)
+		}
+
+		if actual := id.String(); actual != v.Input {
+			t.Fatalf("Expected %q but got %q", v.Input, actual)
+		}
+
+		if _, errors := v.Validate(v.Input, "id"); len(errors) > 0 {
+			t.Fatalf("Expected %q to be valid but got: %+v", v.Input, errors)
+		}
+
+		if _, err := v.Parse(v.Input + "/extra/segment"); err == nil {
+			t.Fatalf("Expected an error parsing %q with an extra segment but didn't get one", v.Input)
+		}
+	}
+}
+`
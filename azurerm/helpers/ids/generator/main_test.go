@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+)
+
+func TestGeneratedCodeIsUpToDate(t *testing.T) {
+	code, tests, err := generate(resourceTypes)
+	if err != nil {
+		t.Fatalf("Error generating Resource IDs: %+v", err)
+	}
+
+	files := map[string][]byte{
+		"../ids_gen.go":      code,
+		"../ids_gen_test.go": tests,
+	}
+	for path, expected := range files {
+		actual, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatalf("Error reading %q: %+v", path, err)
+		}
+
+		if !bytes.Equal(actual, expected) {
+			t.Fatalf("%q is out of date - run `go generate` in `azurerm/helpers/ids` to update it", path)
+		}
+	}
+}
+
+func TestGenerateInvalidTemplates(t *testing.T) {
+	testData := []resourceType{
+		{
+			name:        "NoLeadingSlash",
+			description: "No Leading Slash",
+			template:    "subscriptions/{subscriptionId}/resourceGroups/{name}",
+		},
+		{
+			name:        "OddSegments",
+			description: "Odd Segments",
+			template:    "/subscriptions/{subscriptionId}/resourceGroups",
+		},
+		{
+			name:        "NoName",
+			description: "No Name",
+			template:    "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}",
+		},
+	}
+
+	for _, v := range testData {
+		if _, _, err := generate([]resourceType{v}); err == nil {
+			t.Fatalf("Expected an error generating %q but didn't get one", v.name)
+		}
+	}
+}
+
+func TestUnexportedName(t *testing.T) {
+	testData := map[string]string{
+		"VirtualNetwork":   "virtualNetwork",
+		"HDInsightCluster": "hdInsightCluster",
+		"IotHub":           "iotHub",
+		"DnsARecord":       "dnsARecord",
+	}
+
+	for input, expected := range testData {
+		if actual := unexportedName(input); actual != expected {
+			t.Fatalf("Expected %q for %q but got %q", expected, input, actual)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+// Package ids contains typed Resource IDs for the Resource Types managed by the Provider, which can be parsed from
+// (and converted back into) an Azure Resource Manager ID, and validators for fields referencing those resources.
+//
+// The Resource Types are defined in `./generator` and the code in `ids_gen.go` is generated from these, as such
+// to add a new Resource Type add it to the generator and then run `go generate` in this directory.
+package ids
+
+//go:generate go run ./generator -output ids_gen.go -test-output ids_gen_test.go
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceIDSegment is a key-value pair within a Resource ID, for example `virtualNetworks/{virtualNetworkName}`
+type resourceIDSegment struct {
+	key string
+
+	// value is the fixed value for this segment (e.g. `Microsoft.Network` for the `providers` segment)
+	// or an empty string when this segment contains a user-specified value (such as a name)
+	value string
+}
+
+// resourceIDFormat describes the format of the Resource ID for a given Resource Type
+type resourceIDFormat struct {
+	description string
+	template    string
+	segments    []resourceIDSegment
+}
+
+// newResourceIDFormat parses the template for a Resource ID (in the format `/key/{value}/key/fixedValue`)
+// into a resourceIDFormat - since these templates are defined in code an invalid template will panic
+func newResourceIDFormat(description string, template string) resourceIDFormat {
+	components := strings.Split(strings.TrimPrefix(template, "/"), "/")
+	if !strings.HasPrefix(template, "/") || len(components)%2 != 0 {
+		panic(fmt.Sprintf("Invalid Resource ID template for %s: %q", description, template))
+	}
+
+	segments := make([]resourceIDSegment, 0)
+	for i := 0; i < len(components); i += 2 {
+		segment := resourceIDSegment{
+			key: components[i],
+		}
+
+		if value := components[i+1]; !strings.HasPrefix(value, "{") || !strings.HasSuffix(value, "}") {
+			segment.value = value
+		}
+
+		segments = append(segments, segment)
+	}
+
+	return resourceIDFormat{
+		description: description,
+		template:    template,
+		segments:    segments,
+	}
+}
+
+// id builds a Resource ID from the user-specified values, in the order these are defined in the template
+func (f resourceIDFormat) id(values ...string) string {
+	components := make([]string, 0)
+
+	i := 0
+	for _, segment := range f.segments {
+		value := segment.value
+		if value == "" {
+			value = values[i]
+			i++
+		}
+
+		components = append(components, segment.key, value)
+	}
+
+	return "/" + strings.Join(components, "/")
+}
+
+// parse parses the specified Resource ID, returning the user-specified values in the order these are defined
+// in the template. The keys (and fixed values, such as the Resource Provider) are matched case-insensitively
+// since the casing of these isn't consistent across the Azure API's.
+func (f resourceIDFormat) parse(input string) ([]string, error) {
+	if input == "" {
+		return nil, fmt.Errorf("Expected a %s ID but got an empty string", f.description)
+	}
+
+	if !strings.HasPrefix(input, "/") {
+		return nil, fmt.Errorf("Expected the %s ID %q to begin with a `/` - expected an ID in the format %q", f.description, input, f.template)
+	}
+
+	components := strings.Split(strings.TrimSuffix(strings.TrimPrefix(input, "/"), "/"), "/")
+	if len(components) != len(f.segments)*2 {
+		return nil, fmt.Errorf("Expected the %s ID %q to contain %d segments but got %d - expected an ID in the format %q", f.description, input, len(f.segments)*2, len(components), f.template)
+	}
+
+	values := make([]string, 0)
+	for i, segment := range f.segments {
+		key := components[i*2]
+		value := components[i*2+1]
+
+		if !strings.EqualFold(key, segment.key) {
+			return nil, fmt.Errorf("Expected the segment %q but got %q in the %s ID %q - expected an ID in the format %q", segment.key, key, f.description, input, f.template)
+		}
+
+		if value == "" {
+			return nil, fmt.Errorf("Expected a value for the segment %q in the %s ID %q", segment.key, f.description, input)
+		}
+
+		if segment.value != "" {
+			if !strings.EqualFold(value, segment.value) {
+				return nil, fmt.Errorf("Expected the segment %q to be %q but got %q in the %s ID %q", segment.key, segment.value, value, f.description, input)
+			}
+
+			continue
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// validate is a SchemaValidateFunc which validates that the specified value is a Resource ID in this format
+func (f resourceIDFormat) validate(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := f.parse(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a %s ID: %v", k, f.description, err))
+	}
+
+	return warnings, errors
+}
+
+// ValidateAnyOf returns a SchemaValidateFunc which allows a value which passes any of the specified SchemaValidateFuncs,
+// for example `ids.ValidateAnyOf(ids.ValidateManagedDiskID, ids.ValidateSnapshotID)`
+func ValidateAnyOf(validateFuncs ...schema.SchemaValidateFunc) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		messages := make([]string, 0)
+		for _, validateFunc := range validateFuncs {
+			w, errs := validateFunc(i, k)
+			if len(errs) == 0 {
+				return w, nil
+			}
+
+			for _, err := range errs {
+				messages = append(messages, err.Error())
+			}
+		}
+
+		errors = append(errors, fmt.Errorf("%q didn't match any of the expected formats:\n\n%s", k, strings.Join(messages, "\n")))
+		return warnings, errors
+	}
+}
+
+// ValidateOrEmpty returns a SchemaValidateFunc which allows either an empty string or a value which passes the
+// specified SchemaValidateFunc, for example `ids.ValidateOrEmpty(ids.ValidateSubnetID)`
+func ValidateOrEmpty(validateFunc schema.SchemaValidateFunc) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+			return
+		}
+
+		if v == "" {
+			return
+		}
+
+		return validateFunc(i, k)
+	}
+}
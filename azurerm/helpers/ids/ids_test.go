@@ -0,0 +1,220 @@
+package ids
+
+import (
+	"testing"
+)
+
+func TestParseSubnetID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected *SubnetID
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+		},
+		{
+			Name:  "Not a Resource ID",
+			Input: "subnet1",
+		},
+		{
+			Name:  "Missing Leading Slash",
+			Input: "subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+		},
+		{
+			Name:  "Resource Group ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+		},
+		{
+			Name:  "Virtual Network ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+		},
+		{
+			Name:  "Missing Subnet Name",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/",
+		},
+		{
+			Name:  "Wrong Resource Provider",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualNetworks/network1/subnets/subnet1",
+		},
+		{
+			Name:  "Wrong Resource Type",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/group1/securityRules/rule1",
+		},
+		{
+			Name:  "Subnet ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: &SubnetID{
+				SubscriptionID:     "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:      "group1",
+				VirtualNetworkName: "network1",
+				Name:               "subnet1",
+			},
+		},
+		{
+			Name:  "Subnet ID with a Trailing Slash",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1/",
+			Expected: &SubnetID{
+				SubscriptionID:     "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:      "group1",
+				VirtualNetworkName: "network1",
+				Name:               "subnet1",
+			},
+		},
+		{
+			Name:  "Subnet ID with Different Casing",
+			Input: "/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/resourcegroups/Group1/Providers/microsoft.network/VirtualNetworks/Network1/SUBNETS/Subnet1",
+			Expected: &SubnetID{
+				SubscriptionID:     "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:      "Group1",
+				VirtualNetworkName: "Network1",
+				Name:               "Subnet1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := ParseSubnetID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected no error for %q but got: %+v", v.Name, err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error for %q but didn't get one", v.Name)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
+
+func TestSubnetIDString(t *testing.T) {
+	id := NewSubnetID("00000000-0000-0000-0000-000000000000", "group1", "network1", "subnet1")
+	expected := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"
+
+	if actual := id.String(); actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestParseManagementGroupID(t *testing.T) {
+	id, err := ParseManagementGroupID("/providers/Microsoft.Management/managementGroups/group1")
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if id.Name != "group1" {
+		t.Fatalf("Expected the Name to be %q but got %q", "group1", id.Name)
+	}
+
+	if _, err := ParseManagementGroupID("/providers/Microsoft.Authorization/managementGroups/group1"); err == nil {
+		t.Fatalf("Expected an error parsing a Management Group ID with the wrong Resource Provider but didn't get one")
+	}
+}
+
+func TestValidateSubnetID(t *testing.T) {
+	testData := []struct {
+		Input interface{}
+		Valid bool
+	}{
+		{
+			Input: 1,
+			Valid: false,
+		},
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Valid: false,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Valid: true,
+		},
+	}
+
+	for _, v := range testData {
+		_, errors := ValidateSubnetID(v.Input, "subnet_id")
+		if valid := len(errors) == 0; valid != v.Valid {
+			t.Fatalf("Expected %v to be valid %t but got %t", v.Input, v.Valid, valid)
+		}
+	}
+}
+
+func TestValidateOrEmpty(t *testing.T) {
+	testData := []struct {
+		Input interface{}
+		Valid bool
+	}{
+		{
+			Input: 1,
+			Valid: false,
+		},
+		{
+			Input: "",
+			Valid: true,
+		},
+		{
+			Input: "subnet1",
+			Valid: false,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Valid: true,
+		},
+	}
+
+	validateFunc := ValidateOrEmpty(ValidateSubnetID)
+	for _, v := range testData {
+		_, errors := validateFunc(v.Input, "subnet_id")
+		if valid := len(errors) == 0; valid != v.Valid {
+			t.Fatalf("Expected %v to be valid %t but got %t", v.Input, v.Valid, valid)
+		}
+	}
+}
+
+func TestValidateAnyOf(t *testing.T) {
+	testData := []struct {
+		Input interface{}
+		Valid bool
+	}{
+		{
+			Input: 1,
+			Valid: false,
+		},
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/disks/disk1",
+			Valid: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/snapshots/snapshot1",
+			Valid: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/images/image1",
+			Valid: false,
+		},
+	}
+
+	validateFunc := ValidateAnyOf(ValidateManagedDiskID, ValidateSnapshotID)
+	for _, v := range testData {
+		_, errors := validateFunc(v.Input, "source_resource_id")
+		if valid := len(errors) == 0; valid != v.Valid {
+			t.Fatalf("Expected %v to be valid %t but got %t", v.Input, v.Valid, valid)
+		}
+	}
+}
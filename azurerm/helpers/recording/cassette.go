@@ -0,0 +1,88 @@
+package recording
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Cassette contains the HTTP Interactions recorded for an Acceptance Test, alongside the Variables
+// (such as the random integer used to name resources) which were used when recording them
+type Cassette struct {
+	Variables    map[string]string `json:"variables"`
+	Interactions []Interaction     `json:"interactions"`
+}
+
+// Interaction is a HTTP Request sent to Azure and the Response which was returned
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded HTTP Request - where the Endpoint it was sent to is replaced with a placeholder
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// Response is a recorded HTTP Response
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+func newCassette() *Cassette {
+	return &Cassette{
+		Variables:    make(map[string]string),
+		Interactions: make([]Interaction, 0),
+	}
+}
+
+// LoadCassette loads the Cassette from the specified file
+func LoadCassette(path string) (*Cassette, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading Cassette %q: %+v", path, err)
+	}
+
+	cassette := newCassette()
+	if err := json.Unmarshal(contents, cassette); err != nil {
+		return nil, fmt.Errorf("Error parsing Cassette %q: %+v", path, err)
+	}
+
+	if cassette.Variables == nil {
+		cassette.Variables = make(map[string]string)
+	}
+
+	return cassette, nil
+}
+
+// Save writes the Cassette to the specified file, creating the parent directory if necessary
+func (c *Cassette) Save(path string) error {
+	contents, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Error serializing Cassette %q: %+v", path, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("Error creating the directory for Cassette %q: %+v", path, err)
+	}
+
+	if err := ioutil.WriteFile(path, append(contents, '\n'), 0644); err != nil {
+		return fmt.Errorf("Error writing Cassette %q: %+v", path, err)
+	}
+
+	return nil
+}
+
+// cassetteFileName returns the name of the file containing the Cassette for the specified test - where
+// the separators used by sub-tests are replaced so that each Cassette is a file within the directory
+func cassetteFileName(testName string) string {
+	return strings.NewReplacer("/", "_", "\\", "_", " ", "_").Replace(testName) + ".json"
+}
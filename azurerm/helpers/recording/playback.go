@@ -0,0 +1,117 @@
+package recording
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// playbackAccessToken is the Access Token issued by the in-process server when playing back
+const playbackAccessToken = "playback"
+
+func (s *Session) playbackDecorator(sender autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		// anything which isn't sent to the in-process server would be sent to Azure
+		if !strings.EqualFold(s.server.URL, r.URL.Scheme+"://"+r.URL.Host) {
+			return nil, fmt.Errorf("Requests to %q can't be played back, only requests to Resource Manager and the Graph are recorded", r.URL.Host)
+		}
+
+		return sender.Do(r)
+	})
+}
+
+// ServeHTTP plays back the next recorded Interaction for the request, issuing an Access Token for any
+// requests to Azure Active Directory
+func (s *Session) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if isTokenRequest(r.URL) {
+		writePlaybackAccessToken(w, r)
+		return
+	}
+
+	if r.Body != nil {
+		io.Copy(ioutil.Discard, r.Body) //nolint: errcheck
+		r.Body.Close()
+	}
+
+	interaction, ok := s.nextInteraction(r.Method, endpointPlaceholder+r.URL.RequestURI())
+	if !ok {
+		log.Printf("[DEBUG] No Interaction was recorded for %s %s", r.Method, r.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotImplemented)
+		writeJson(w, map[string]interface{}{
+			"error": map[string]string{
+				"code":    "InteractionNotRecorded",
+				"message": fmt.Sprintf("No Interaction was recorded for %s %s", r.Method, r.URL.RequestURI()),
+			},
+		})
+		return
+	}
+
+	for key, values := range interaction.Response.Headers {
+		for _, value := range values {
+			w.Header().Add(key, s.denormalize(value))
+		}
+	}
+
+	// the body may have changed length, and there's no need to wait before polling a long-running operation
+	w.Header().Del("Content-Length")
+	w.Header().Set("Retry-After", "0")
+
+	w.WriteHeader(interaction.Response.StatusCode)
+	io.WriteString(w, s.denormalize(interaction.Response.Body)) //nolint: errcheck
+}
+
+// nextInteraction returns the next Interaction recorded for the specified request - where once each of the
+// recorded Interactions has been played back, the last one is repeated (since the number of requests made
+// whilst polling, or when configuring the Provider, can differ between runs)
+func (s *Session) nextInteraction(method, url string) (*Interaction, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := interactionKey(method, url)
+	interactions := s.interactions[key]
+	if len(interactions) == 0 {
+		return nil, false
+	}
+
+	index := s.served[key]
+	if index >= len(interactions) {
+		index = len(interactions) - 1
+	}
+	s.served[key] = index + 1
+
+	return &interactions[index], true
+}
+
+// denormalize replaces the placeholder for the endpoints with the URL of the in-process server
+func (s *Session) denormalize(input string) string {
+	return strings.Replace(input, endpointPlaceholder, s.server.URL, -1)
+}
+
+func writePlaybackAccessToken(w http.ResponseWriter, r *http.Request) {
+	now := time.Now()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	writeJson(w, map[string]string{
+		"access_token": playbackAccessToken,
+		"token_type":   "Bearer",
+		"expires_in":   "3600",
+		"expires_on":   strconv.FormatInt(now.Add(time.Hour).Unix(), 10),
+		"not_before":   strconv.FormatInt(now.Unix(), 10),
+		"resource":     r.FormValue("resource"),
+	})
+}
+
+func writeJson(w io.Writer, value interface{}) {
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		log.Printf("[DEBUG] Error writing the played back Response: %+v", err)
+	}
+}
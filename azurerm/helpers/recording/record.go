@@ -0,0 +1,157 @@
+package recording
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// pollingHeaders are the Response Headers containing the URL which is polled to track a long-running operation,
+// requests to which are recorded into the same Cassette as the request which started the operation
+var pollingHeaders = []string{
+	"Azure-AsyncOperation",
+	"Location",
+}
+
+func (s *Session) recordDecorator(sender autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		// requests for an Access Token contain credentials, so are never recorded
+		if isTokenRequest(r.URL) {
+			return sender.Do(r)
+		}
+
+		requestBody, err := readBody(&r.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := sender.Do(r)
+		if err != nil || resp == nil {
+			return resp, err
+		}
+
+		responseBody, err := readBody(&resp.Body)
+		if err != nil {
+			return resp, err
+		}
+
+		if err := s.addInteraction(r, requestBody, resp, responseBody); err != nil {
+			// a recording which can't be saved doesn't affect the test itself, which will fail when played back
+			log.Printf("[DEBUG] Error recording the Interaction for %s %s: %+v", r.Method, s.requestUrl(r.URL), err)
+		}
+
+		return resp, nil
+	})
+}
+
+func (s *Session) addInteraction(r *http.Request, requestBody string, resp *http.Response, responseBody string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	headers := make(http.Header)
+	for key, values := range resp.Header {
+		if azure.IsSensitiveHeader(key) {
+			continue
+		}
+
+		for _, value := range values {
+			headers.Add(key, s.normalize(value))
+		}
+	}
+
+	interaction := Interaction{
+		Request: Request{
+			Method: r.Method,
+			URL:    s.normalize(s.requestUrl(r.URL)),
+			Body:   s.normalize(azure.RedactBody(requestBody, r.URL)),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    headers,
+			Body:       s.normalize(azure.RedactBody(responseBody, r.URL)),
+		},
+	}
+
+	testName := s.testNameForUrl(interaction.Request.URL)
+	if testName != sharedCassetteName {
+		for _, header := range pollingHeaders {
+			if pollingUrl := interaction.Response.Headers.Get(header); pollingUrl != "" {
+				s.pollingUrls[pollingUrl] = testName
+			}
+		}
+	}
+
+	name := cassetteFileName(testName)
+	cassette, ok := s.cassettes[name]
+	if !ok {
+		cassette = newCassette()
+		s.cassettes[name] = cassette
+	}
+
+	cassette.Interactions = append(cassette.Interactions, interaction)
+	return cassette.Save(filepath.Join(s.directory, name))
+}
+
+// testNameForUrl returns the name of the test which a (normalized) request URL should be attributed to
+func (s *Session) testNameForUrl(url string) string {
+	if testName, ok := s.pollingUrls[url]; ok {
+		return testName
+	}
+
+	for token, testName := range s.tokens {
+		if strings.Contains(url, token) {
+			return testName
+		}
+	}
+
+	return sharedCassetteName
+}
+
+// requestUrl returns the URL of the request with the signature of any Shared Access Signature masked
+func (s *Session) requestUrl(uri *url.URL) string {
+	return uri.Scheme + "://" + uri.Host + azure.RedactRequestUri(uri)
+}
+
+// normalize replaces the Resource Manager and Graph endpoints (and any substitutions, such as the
+// Subscription ID) within the input with placeholders, such that the Cassettes can be played back.
+// NOTE: the lock must be held by the caller
+func (s *Session) normalize(input string) string {
+	for _, endpoint := range s.endpoints {
+		input = strings.Replace(input, endpoint, endpointPlaceholder, -1)
+	}
+
+	for _, v := range s.substitutions {
+		input = v.regex.ReplaceAllLiteralString(input, v.placeholder)
+	}
+
+	return input
+}
+
+// isTokenRequest determines whether the request is for an Access Token from Azure Active Directory
+func isTokenRequest(uri *url.URL) bool {
+	return strings.HasSuffix(strings.TrimSuffix(uri.Path, "/"), "/oauth2/token")
+}
+
+// readBody reads the body of a Request or Response, replacing it such that it can be read again
+func readBody(body *io.ReadCloser) (string, error) {
+	if *body == nil || *body == http.NoBody {
+		return "", nil
+	}
+
+	contents, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return "", err
+	}
+
+	*body = ioutil.NopCloser(bytes.NewReader(contents))
+	return string(contents), nil
+}
@@ -0,0 +1,325 @@
+// Package recording allows the Acceptance Tests to run without access to Azure, by recording the HTTP
+// Interactions between the Provider and Azure into a Cassette per test - which are then played back from
+// an in-process server, to which the endpoints of the Azure Environment are pointed.
+package recording
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Mode determines whether the HTTP Interactions with Azure are sent as-is, recorded or played back
+type Mode string
+
+const (
+	// ModeLive sends requests to Azure without recording them
+	ModeLive Mode = "live"
+
+	// ModeRecord sends requests to Azure and records each Interaction into the Cassette for the test
+	ModeRecord Mode = "record"
+
+	// ModePlayback serves each request from the recorded Cassettes, without sending any requests to Azure
+	ModePlayback Mode = "playback"
+)
+
+const (
+	// PlaceholderSubscriptionId replaces the ID of the Subscription used when recording
+	PlaceholderSubscriptionId = "00000000-0000-0000-0000-000000000000"
+
+	// PlaceholderTenantId replaces the ID of the Tenant used when recording
+	PlaceholderTenantId = "11111111-1111-1111-1111-111111111111"
+
+	// PlaceholderClientId replaces the ID of the Service Principal used when recording
+	PlaceholderClientId = "22222222-2222-2222-2222-222222222222"
+
+	// PlaceholderClientSecret is the Client Secret used to authenticate against the in-process server when playing back
+	PlaceholderClientSecret = "playback"
+)
+
+const (
+	// endpointPlaceholder replaces the Resource Manager and Graph endpoints within a recorded Interaction
+	endpointPlaceholder = "{{endpoint}}"
+
+	// sharedCassetteName is the name of the Cassette containing the Interactions which can't be attributed
+	// to a specific test (for example, listing the Resource Providers when the Provider is configured)
+	sharedCassetteName = "_shared"
+)
+
+// ParseMode parses the specified Recording Mode, where an empty value means the tests are run live
+func ParseMode(input string) (Mode, error) {
+	if input == "" {
+		return ModeLive, nil
+	}
+
+	for _, mode := range []Mode{ModeLive, ModeRecord, ModePlayback} {
+		if strings.EqualFold(input, string(mode)) {
+			return mode, nil
+		}
+	}
+
+	return "", fmt.Errorf("Unsupported Recording Mode %q - expected one of %q, %q or %q", input, ModeLive, ModeRecord, ModePlayback)
+}
+
+// Session records (or plays back) the HTTP Interactions for all of the tests within a test run
+type Session struct {
+	mode      Mode
+	directory string
+
+	lock      sync.Mutex
+	cassettes map[string]*Cassette
+
+	// used when recording
+	endpoints     []string
+	substitutions []substitution
+	tokens        map[string]string
+	pollingUrls   map[string]string
+
+	// used when playing back
+	server       *httptest.Server
+	interactions map[string][]Interaction
+	served       map[string]int
+}
+
+type substitution struct {
+	regex       *regexp.Regexp
+	placeholder string
+}
+
+// NewSession returns a Session which records Cassettes into (or plays back Cassettes from) the specified directory
+func NewSession(mode Mode, directory string) (*Session, error) {
+	s := &Session{
+		mode:      mode,
+		directory: directory,
+		cassettes: make(map[string]*Cassette),
+	}
+
+	switch mode {
+	case ModeRecord:
+		s.tokens = make(map[string]string)
+		s.pollingUrls = make(map[string]string)
+		return s, nil
+
+	case ModePlayback:
+		if err := s.loadCassettes(); err != nil {
+			return nil, err
+		}
+
+		s.served = make(map[string]int)
+		s.server = httptest.NewServer(s)
+		return s, nil
+	}
+
+	return nil, fmt.Errorf("A Session can only be created to record or play back HTTP Interactions, not when running %q", mode)
+}
+
+// Mode returns whether this Session is recording or playing back HTTP Interactions
+func (s *Session) Mode() Mode {
+	return s.mode
+}
+
+// Close stops the server used to play back HTTP Interactions
+func (s *Session) Close() {
+	if s.server != nil {
+		s.server.Close()
+	}
+}
+
+// AddSubstitution replaces all occurrences of the specified value (for example, the Subscription ID) with the
+// placeholder when recording - such that the Cassettes can be played back without access to the Subscription
+func (s *Session) AddSubstitution(value, placeholder string) {
+	if value == "" {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.substitutions = append(s.substitutions, substitution{
+		regex:       regexp.MustCompile("(?i)" + regexp.QuoteMeta(value)),
+		placeholder: placeholder,
+	})
+}
+
+// Attribute records each request whose URL contains the specified token (for example, the random integer
+// used to name the resources within a test) into the Cassette for the specified test
+func (s *Session) Attribute(testName, token string) {
+	if s.mode != ModeRecord || token == "" {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.tokens[token] = testName
+}
+
+// HasCassette determines whether a Cassette has been recorded for the specified test
+func (s *Session) HasCassette(testName string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, ok := s.cassettes[cassetteFileName(testName)]
+	return ok
+}
+
+// Variable returns the value of the specified Variable for a test, which is generated and stored in the
+// test's Cassette when recording - and read from the test's Cassette when playing back.
+func (s *Session) Variable(testName, key string, generate func() string) (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	name := cassetteFileName(testName)
+	cassette, ok := s.cassettes[name]
+
+	if s.mode == ModePlayback {
+		if !ok {
+			return "", fmt.Errorf("No Cassette was found for %q in %q - the test needs to be recorded before it can be played back", testName, s.directory)
+		}
+
+		value, ok := cassette.Variables[key]
+		if !ok {
+			return "", fmt.Errorf("No value was recorded for the Variable %q in the Cassette for %q", key, testName)
+		}
+
+		return value, nil
+	}
+
+	if !ok {
+		cassette = newCassette()
+		s.cassettes[name] = cassette
+	}
+
+	if value, ok := cassette.Variables[key]; ok {
+		return value, nil
+	}
+
+	value := generate()
+	cassette.Variables[key] = value
+	return value, cassette.Save(filepath.Join(s.directory, name))
+}
+
+// Environment returns the Azure Environment which should be used within this Session: when playing back the
+// Resource Manager, Graph and Active Directory endpoints are pointed at the in-process server.
+func (s *Session) Environment(env azure.Environment) azure.Environment {
+	if s.mode == ModePlayback {
+		endpoint := s.server.URL + "/"
+		env.ResourceManagerEndpoint = endpoint
+		env.GraphEndpoint = endpoint
+		env.ActiveDirectoryEndpoint = endpoint
+		return env
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, endpoint := range []string{env.ResourceManagerEndpoint, env.GraphEndpoint} {
+		if origin := endpointOrigin(endpoint); origin != "" && !containsString(s.endpoints, origin) {
+			s.endpoints = append(s.endpoints, origin)
+		}
+	}
+
+	return env
+}
+
+// SendDecorator returns a decorator which records each HTTP Interaction when recording - or which ensures that
+// requests are only sent to the in-process server when playing back
+func (s *Session) SendDecorator() autorest.SendDecorator {
+	if s.mode == ModePlayback {
+		return s.playbackDecorator
+	}
+
+	return s.recordDecorator
+}
+
+func (s *Session) loadCassettes() error {
+	s.interactions = make(map[string][]Interaction)
+
+	files, err := ioutil.ReadDir(s.directory)
+	if os.IsNotExist(err) {
+		// nothing has been recorded yet
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error listing the Cassettes within %q: %+v", s.directory, err)
+	}
+
+	names := make([]string, 0)
+	for _, file := range files {
+		if !file.IsDir() && strings.HasSuffix(file.Name(), ".json") {
+			names = append(names, file.Name())
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		cassette, err := LoadCassette(filepath.Join(s.directory, name))
+		if err != nil {
+			return err
+		}
+
+		s.cassettes[name] = cassette
+		for _, interaction := range cassette.Interactions {
+			key := interactionKey(interaction.Request.Method, interaction.Request.URL)
+			s.interactions[key] = append(s.interactions[key], interaction)
+		}
+	}
+
+	return nil
+}
+
+func interactionKey(method, url string) string {
+	return fmt.Sprintf("%s %s", strings.ToUpper(method), url)
+}
+
+// endpointOrigin returns the Scheme and Host of the specified endpoint (e.g. `https://management.azure.com`)
+func endpointOrigin(endpoint string) string {
+	uri, err := url.Parse(endpoint)
+	if err != nil || uri.Host == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s://%s", uri.Scheme, uri.Host)
+}
+
+func containsString(input []string, value string) bool {
+	for _, v := range input {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+var (
+	currentSession     *Session
+	currentSessionLock sync.RWMutex
+)
+
+// SetCurrentSession sets the Session used by the Provider when it's configured
+func SetCurrentSession(s *Session) {
+	currentSessionLock.Lock()
+	defer currentSessionLock.Unlock()
+
+	currentSession = s
+}
+
+// CurrentSession returns the Session used by the Provider, which is nil unless an
+// Acceptance Test is recording or playing back HTTP Interactions
+func CurrentSession() *Session {
+	currentSessionLock.RLock()
+	defer currentSessionLock.RUnlock()
+
+	return currentSession
+}
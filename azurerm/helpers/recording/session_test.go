@@ -0,0 +1,292 @@
+package recording
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+const (
+	testSubscriptionId = "12345678-1234-1234-1234-123456789012"
+	testName           = "TestAccAzureRMResourceGroup_basic"
+)
+
+func TestParseMode(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected Mode
+		Error    bool
+	}{
+		{
+			Input:    "",
+			Expected: ModeLive,
+		},
+		{
+			Input:    "live",
+			Expected: ModeLive,
+		},
+		{
+			Input:    "Record",
+			Expected: ModeRecord,
+		},
+		{
+			Input:    "PLAYBACK",
+			Expected: ModePlayback,
+		},
+		{
+			Input: "replay",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		actual, err := ParseMode(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error for %q but got: %+v", v.Input, err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error for %q but didn't get one", v.Input)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q for %q but got %q", v.Expected, v.Input, actual)
+		}
+	}
+}
+
+func TestCassetteFileName(t *testing.T) {
+	if actual := cassetteFileName("TestAccAzureRMResourceGroup/basic"); actual != "TestAccAzureRMResourceGroup_basic.json" {
+		t.Fatalf("Expected %q but got %q", "TestAccAzureRMResourceGroup_basic.json", actual)
+	}
+}
+
+func TestSession_recordAndPlayback(t *testing.T) {
+	directory, err := ioutil.TempDir("", "recordings")
+	if err != nil {
+		t.Fatalf("Error creating the directory: %+v", err)
+	}
+	defer os.RemoveAll(directory)
+
+	// record against a fake Resource Manager
+	azureServer := httptest.NewServer(testFakeResourceManager())
+	defer azureServer.Close()
+
+	recorder, err := NewSession(ModeRecord, directory)
+	if err != nil {
+		t.Fatalf("Error creating the Recording Session: %+v", err)
+	}
+	recorder.AddSubstitution(testSubscriptionId, PlaceholderSubscriptionId)
+
+	randomInt, err := recorder.Variable(testName, "random_int", func() string { return "190101000000001234" })
+	if err != nil {
+		t.Fatalf("Error generating the Variable: %+v", err)
+	}
+	recorder.Attribute(testName, randomInt)
+
+	env := recorder.Environment(azure.Environment{
+		ResourceManagerEndpoint: azureServer.URL + "/",
+		GraphEndpoint:           "https://graph.windows.net/",
+	})
+	testSendRequests(t, testSender(recorder), env.ResourceManagerEndpoint, testSubscriptionId, randomInt)
+
+	cassette, err := LoadCassette(filepath.Join(directory, testName+".json"))
+	if err != nil {
+		t.Fatalf("Error loading the recorded Cassette: %+v", err)
+	}
+
+	if len(cassette.Interactions) != 4 {
+		t.Fatalf("Expected 4 Interactions to be recorded for the test but got %d", len(cassette.Interactions))
+	}
+
+	shared, err := LoadCassette(filepath.Join(directory, sharedCassetteName+".json"))
+	if err != nil {
+		t.Fatalf("Error loading the shared Cassette: %+v", err)
+	}
+
+	if len(shared.Interactions) != 1 {
+		t.Fatalf("Expected 1 shared Interaction to be recorded but got %d", len(shared.Interactions))
+	}
+
+	contents, err := ioutil.ReadFile(filepath.Join(directory, testName+".json"))
+	if err != nil {
+		t.Fatalf("Error reading the recorded Cassette: %+v", err)
+	}
+
+	for _, value := range []string{testSubscriptionId, azureServer.URL, "super-secret"} {
+		if strings.Contains(string(contents), value) {
+			t.Fatalf("Expected the recorded Cassette not to contain %q", value)
+		}
+	}
+
+	// then play it back without the fake Resource Manager
+	azureServer.Close()
+
+	player, err := NewSession(ModePlayback, directory)
+	if err != nil {
+		t.Fatalf("Error creating the Playback Session: %+v", err)
+	}
+	defer player.Close()
+
+	playedBackInt, err := player.Variable(testName, "random_int", nil)
+	if err != nil {
+		t.Fatalf("Error retrieving the Variable: %+v", err)
+	}
+
+	if playedBackInt != randomInt {
+		t.Fatalf("Expected the Variable to be %q but got %q", randomInt, playedBackInt)
+	}
+
+	if _, err := player.Variable("TestAccAzureRMResourceGroup_notRecorded", "random_int", nil); err == nil {
+		t.Fatalf("Expected an error retrieving a Variable for a test which hasn't been recorded but didn't get one")
+	}
+
+	env = player.Environment(azure.Environment{
+		ResourceManagerEndpoint: "https://management.azure.com/",
+		ActiveDirectoryEndpoint: "https://login.microsoftonline.com/",
+		GraphEndpoint:           "https://graph.windows.net/",
+		KeyVaultDNSSuffix:       "vault.azure.net",
+	})
+	sender := testSender(player)
+	testSendRequests(t, sender, env.ResourceManagerEndpoint, PlaceholderSubscriptionId, playedBackInt)
+
+	// once each of the recorded Interactions has been played back the last one is repeated
+	for i := 0; i < 2; i++ {
+		resp := testSendRequest(t, sender, http.MethodGet, fmt.Sprintf("%ssubscriptions/%s/providers", env.ResourceManagerEndpoint, PlaceholderSubscriptionId))
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Expected the shared Interaction to be repeated but got %d", resp.StatusCode)
+		}
+	}
+
+	resp := testSendRequest(t, sender, http.MethodGet, fmt.Sprintf("%ssubscriptions/%s/resourceGroups/other", env.ResourceManagerEndpoint, PlaceholderSubscriptionId))
+	if resp.StatusCode != http.StatusNotImplemented {
+		t.Fatalf("Expected a request which wasn't recorded to return %d but got %d", http.StatusNotImplemented, resp.StatusCode)
+	}
+
+	token := testSendRequest(t, sender, http.MethodPost, env.ActiveDirectoryEndpoint+PlaceholderTenantId+"/oauth2/token")
+	if body := testReadBody(t, token); !strings.Contains(body, playbackAccessToken) {
+		t.Fatalf("Expected an Access Token to be issued but got %q", body)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://example"+env.KeyVaultDNSSuffix+"/secrets/example", nil)
+	if _, err := sender.Do(req); err == nil {
+		t.Fatalf("Expected an error sending a request to Key Vault when playing back but didn't get one")
+	}
+}
+
+// testSendRequests creates and then deletes a Resource Group, polling until the deletion has completed
+func testSendRequests(t *testing.T, sender autorest.Sender, endpoint, subscriptionId, randomInt string) {
+	resp := testSendRequest(t, sender, http.MethodGet, fmt.Sprintf("%ssubscriptions/%s/providers", endpoint, subscriptionId))
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected listing the Resource Providers to return %d but got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	resourceGroupUrl := fmt.Sprintf("%ssubscriptions/%s/resourceGroups/acctestRG-%s?api-version=2018-05-01", endpoint, subscriptionId, randomInt)
+	resp = testSendRequest(t, sender, http.MethodPut, resourceGroupUrl)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected creating the Resource Group to return %d but got %d", http.StatusCreated, resp.StatusCode)
+	}
+
+	expectedId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%s", subscriptionId, randomInt)
+	if body := testReadBody(t, resp); !strings.Contains(body, expectedId) {
+		t.Fatalf("Expected the Resource Group to contain the ID %q but got %q", expectedId, body)
+	}
+
+	resp = testSendRequest(t, sender, http.MethodDelete, resourceGroupUrl)
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("Expected deleting the Resource Group to return %d but got %d", http.StatusAccepted, resp.StatusCode)
+	}
+
+	pollingUrl := resp.Header.Get("Location")
+	if !strings.HasPrefix(pollingUrl, endpoint) {
+		t.Fatalf("Expected the Polling URL %q to be relative to %q", pollingUrl, endpoint)
+	}
+
+	for _, expected := range []int{http.StatusAccepted, http.StatusOK} {
+		resp = testSendRequest(t, sender, http.MethodGet, pollingUrl)
+		if resp.StatusCode != expected {
+			t.Fatalf("Expected polling the deletion to return %d but got %d", expected, resp.StatusCode)
+		}
+	}
+}
+
+func testSendRequest(t *testing.T, sender autorest.Sender, method, uri string) *http.Response {
+	req, err := http.NewRequest(method, uri, strings.NewReader(`{"location": "westeurope"}`))
+	if err != nil {
+		t.Fatalf("Error building the request: %+v", err)
+	}
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("Error sending %s %s: %+v", method, uri, err)
+	}
+
+	return resp
+}
+
+func testReadBody(t *testing.T, resp *http.Response) string {
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Error reading the response: %+v", err)
+	}
+
+	return string(body)
+}
+
+func testSender(s *Session) autorest.Sender {
+	return autorest.DecorateSender(&http.Client{}, s.SendDecorator())
+}
+
+// testFakeResourceManager returns a handler which behaves like Resource Manager, for a single Resource Group
+func testFakeResourceManager() http.Handler {
+	polls := 0
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/providers"):
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, `{"value": []}`)
+
+		case strings.Contains(r.URL.Path, "/operationresults/"):
+			polls++
+			if polls == 1 {
+				w.WriteHeader(http.StatusAccepted)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+
+		case r.Method == http.MethodPut:
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"id": %q, "location": "westeurope", "tags": {"password": "super-secret"}}`, r.URL.Path)
+
+		case r.Method == http.MethodDelete:
+			pollingUrl := url.URL{
+				Scheme:   "http",
+				Host:     r.Host,
+				Path:     fmt.Sprintf("/subscriptions/%s/operationresults/%s", testSubscriptionId, strings.TrimPrefix(r.URL.Path[strings.LastIndex(r.URL.Path, "/"):], "/")),
+				RawQuery: "api-version=2018-05-01",
+			}
+			w.Header().Set("Location", pollingUrl.String())
+			w.WriteHeader(http.StatusAccepted)
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package timeouts
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ForCreate returns the context wrapped with the timeout for a Create operation
+//
+// When the timeout has already been started for this resource (see StartCreate) the existing deadline is used
+func ForCreate(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	if deadline, ok := startedCreates.deadline(d); ok {
+		return context.WithDeadline(ctx, deadline)
+	}
+
+	return buildWithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+}
+
+// StartCreate starts the timeout for a Create operation ahead of calling the resource's Create function, such that
+// any work done beforehand counts towards the same timeout - rather than the Create function starting a new one.
+//
+// The returned CancelFunc must be called once the Create function has returned.
+func StartCreate(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	ctx, cancel := buildWithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	deadline, _ := ctx.Deadline()
+	startedCreates.add(d, deadline)
+
+	return ctx, func() {
+		startedCreates.remove(d)
+		cancel()
+	}
+}
+
+var startedCreates = &startedTimeouts{
+	deadlines: make(map[*schema.ResourceData]time.Time),
+}
+
+type startedTimeouts struct {
+	lock      sync.Mutex
+	deadlines map[*schema.ResourceData]time.Time
+}
+
+func (t *startedTimeouts) add(d *schema.ResourceData, deadline time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.deadlines[d] = deadline
+}
+
+func (t *startedTimeouts) deadline(d *schema.ResourceData) (time.Time, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	deadline, ok := t.deadlines[d]
+	return deadline, ok
+}
+
+func (t *startedTimeouts) remove(d *schema.ResourceData) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.deadlines, d)
+}
+
+// ForCreateUpdate returns the context wrapped with the timeout for a combined Create/Update operation
+//
+// This function will determine if this is a Create or an Update based on the presence of a Resource ID
+func ForCreateUpdate(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	if d.IsNewResource() {
+		return ForCreate(ctx, d)
+	}
+
+	return ForUpdate(ctx, d)
+}
+
+// ForDelete returns the context wrapped with the timeout for a Delete operation
+func ForDelete(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	return buildWithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+}
+
+// ForRead returns the context wrapped with the timeout for a Read operation
+func ForRead(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	return buildWithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+}
+
+// ForUpdate returns the context wrapped with the timeout for an Update operation
+func ForUpdate(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	return buildWithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+}
+
+// buildWithTimeout returns a context bound to the specified timeout - which in turn bounds
+// any long-running operations polled using this context, since the Azure SDK won't override
+// an existing deadline with the clients' PollingDuration.
+func buildWithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,47 @@
+package timeouts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestForCreateUsesStartedDeadline(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+	d := resource.TestResourceData()
+
+	startedCtx, cancel := StartCreate(context.Background(), d)
+	startedDeadline, _ := startedCtx.Deadline()
+
+	time.Sleep(10 * time.Millisecond)
+
+	ctx, cancelCreate := ForCreate(context.Background(), d)
+	deadline, _ := ctx.Deadline()
+	cancelCreate()
+
+	if !deadline.Equal(startedDeadline) {
+		t.Fatalf("Expected the started deadline %s to be used but got %s", startedDeadline, deadline)
+	}
+
+	cancel()
+
+	ctx, cancelCreate = ForCreate(context.Background(), d)
+	defer cancelCreate()
+	deadline, _ = ctx.Deadline()
+
+	if !deadline.After(startedDeadline) {
+		t.Fatalf("Expected a new deadline once the started timeout was cancelled but got %s", deadline)
+	}
+}
@@ -5,13 +5,18 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 )
 
@@ -37,12 +42,35 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_TENANT_ID", ""),
 			},
 
+			"auxiliary_tenant_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 3,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+			},
+
 			"environment": {
 				Type:        schema.TypeString,
 				Required:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_ENVIRONMENT", "public"),
 			},
 
+			// Custom Environments (e.g. an Azure Stack Hub)
+			"metadata_host": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_METADATA_HOST", ""),
+			},
+
+			"environment_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_ENVIRONMENT_FILE", ""),
+			},
+
 			// Client Certificate specific fields
 			"client_certificate_password": {
 				Type:        schema.TypeString,
@@ -83,6 +111,44 @@ func Provider() terraform.ResourceProvider {
 				ValidateFunc: validate.UUIDOrEmpty,
 			},
 
+			// Retries for requests which are throttled or fail with a transient error
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRIES", 8),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"max_retry_delay_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRY_DELAY_IN_SECONDS", 60),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			// Logging
+			"log_request_summary_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_LOG_REQUEST_SUMMARY_ONLY", false),
+			},
+
+			// Tags applied to (or ignored by) every resource
+			"default_tags": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateAzureRMTags,
+			},
+
+			"ignore_tag_prefixes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+
 			// Advanced feature flags
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
@@ -95,81 +161,100 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_PROVIDER_REGISTRATION", false),
 			},
+
+			"resource_providers_to_register": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				Set: schema.HashString,
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"azurerm_api_management":                         dataSourceApiManagementService(),
-			"azurerm_api_management_api":                     dataSourceApiManagementApi(),
-			"azurerm_api_management_group":                   dataSourceApiManagementGroup(),
-			"azurerm_api_management_product":                 dataSourceApiManagementProduct(),
-			"azurerm_api_management_user":                    dataSourceArmApiManagementUser(),
-			"azurerm_app_service_plan":                       dataSourceAppServicePlan(),
-			"azurerm_app_service":                            dataSourceArmAppService(),
-			"azurerm_application_insights":                   dataSourceArmApplicationInsights(),
-			"azurerm_application_security_group":             dataSourceArmApplicationSecurityGroup(),
-			"azurerm_availability_set":                       dataSourceArmAvailabilitySet(),
-			"azurerm_azuread_application":                    dataSourceArmAzureADApplication(),
-			"azurerm_azuread_service_principal":              dataSourceArmActiveDirectoryServicePrincipal(),
-			"azurerm_batch_account":                          dataSourceArmBatchAccount(),
-			"azurerm_batch_certificate":                      dataSourceArmBatchCertificate(),
-			"azurerm_batch_pool":                             dataSourceArmBatchPool(),
-			"azurerm_builtin_role_definition":                dataSourceArmBuiltInRoleDefinition(),
-			"azurerm_cdn_profile":                            dataSourceArmCdnProfile(),
-			"azurerm_client_config":                          dataSourceArmClientConfig(),
-			"azurerm_container_registry":                     dataSourceArmContainerRegistry(),
-			"azurerm_cosmosdb_account":                       dataSourceArmCosmosDBAccount(),
-			"azurerm_data_lake_store":                        dataSourceArmDataLakeStoreAccount(),
-			"azurerm_dev_test_lab":                           dataSourceArmDevTestLab(),
-			"azurerm_dns_zone":                               dataSourceArmDnsZone(),
-			"azurerm_eventhub_namespace":                     dataSourceEventHubNamespace(),
-			"azurerm_express_route_circuit":                  dataSourceArmExpressRouteCircuit(),
-			"azurerm_firewall":                               dataSourceArmFirewall(),
-			"azurerm_image":                                  dataSourceArmImage(),
-			"azurerm_hdinsight_cluster":                      dataSourceArmHDInsightSparkCluster(),
-			"azurerm_key_vault_access_policy":                dataSourceArmKeyVaultAccessPolicy(),
-			"azurerm_key_vault_key":                          dataSourceArmKeyVaultKey(),
-			"azurerm_key_vault_secret":                       dataSourceArmKeyVaultSecret(),
-			"azurerm_key_vault":                              dataSourceArmKeyVault(),
-			"azurerm_kubernetes_cluster":                     dataSourceArmKubernetesCluster(),
-			"azurerm_lb":                                     dataSourceArmLoadBalancer(),
-			"azurerm_lb_backend_address_pool":                dataSourceArmLoadBalancerBackendAddressPool(),
-			"azurerm_log_analytics_workspace":                dataSourceLogAnalyticsWorkspace(),
-			"azurerm_logic_app_workflow":                     dataSourceArmLogicAppWorkflow(),
-			"azurerm_managed_disk":                           dataSourceArmManagedDisk(),
-			"azurerm_management_group":                       dataSourceArmManagementGroup(),
-			"azurerm_monitor_action_group":                   dataSourceArmMonitorActionGroup(),
-			"azurerm_monitor_diagnostic_categories":          dataSourceArmMonitorDiagnosticCategories(),
-			"azurerm_monitor_log_profile":                    dataSourceArmMonitorLogProfile(),
-			"azurerm_network_interface":                      dataSourceArmNetworkInterface(),
-			"azurerm_network_security_group":                 dataSourceArmNetworkSecurityGroup(),
-			"azurerm_network_watcher":                        dataSourceArmNetworkWatcher(),
-			"azurerm_notification_hub_namespace":             dataSourceNotificationHubNamespace(),
-			"azurerm_notification_hub":                       dataSourceNotificationHub(),
-			"azurerm_platform_image":                         dataSourceArmPlatformImage(),
-			"azurerm_policy_definition":                      dataSourceArmPolicyDefinition(),
-			"azurerm_public_ip":                              dataSourceArmPublicIP(),
-			"azurerm_public_ips":                             dataSourceArmPublicIPs(),
-			"azurerm_recovery_services_vault":                dataSourceArmRecoveryServicesVault(),
-			"azurerm_recovery_services_protection_policy_vm": dataSourceArmRecoveryServicesProtectionPolicyVm(),
-			"azurerm_resource_group":                         dataSourceArmResourceGroup(),
-			"azurerm_role_definition":                        dataSourceArmRoleDefinition(),
-			"azurerm_route_table":                            dataSourceArmRouteTable(),
-			"azurerm_scheduler_job_collection":               dataSourceArmSchedulerJobCollection(),
-			"azurerm_servicebus_namespace":                   dataSourceArmServiceBusNamespace(),
-			"azurerm_shared_image_gallery":                   dataSourceArmSharedImageGallery(),
-			"azurerm_shared_image_version":                   dataSourceArmSharedImageVersion(),
-			"azurerm_shared_image":                           dataSourceArmSharedImage(),
-			"azurerm_snapshot":                               dataSourceArmSnapshot(),
-			"azurerm_stream_analytics_job":                   dataSourceArmStreamAnalyticsJob(),
-			"azurerm_storage_account_sas":                    dataSourceArmStorageAccountSharedAccessSignature(),
-			"azurerm_storage_account":                        dataSourceArmStorageAccount(),
-			"azurerm_subnet":                                 dataSourceArmSubnet(),
-			"azurerm_subscription":                           dataSourceArmSubscription(),
-			"azurerm_subscriptions":                          dataSourceArmSubscriptions(),
-			"azurerm_traffic_manager_geographical_location":  dataSourceArmTrafficManagerGeographicalLocation(),
-			"azurerm_virtual_machine":                        dataSourceArmVirtualMachine(),
-			"azurerm_virtual_network_gateway":                dataSourceArmVirtualNetworkGateway(),
-			"azurerm_virtual_network":                        dataSourceArmVirtualNetwork(),
+			"azurerm_api_management":                             dataSourceApiManagementService(),
+			"azurerm_api_management_api":                         dataSourceApiManagementApi(),
+			"azurerm_api_management_group":                       dataSourceApiManagementGroup(),
+			"azurerm_api_management_product":                     dataSourceApiManagementProduct(),
+			"azurerm_api_management_user":                        dataSourceArmApiManagementUser(),
+			"azurerm_app_service_plan":                           dataSourceAppServicePlan(),
+			"azurerm_app_service":                                dataSourceArmAppService(),
+			"azurerm_application_insights":                       dataSourceArmApplicationInsights(),
+			"azurerm_application_security_group":                 dataSourceArmApplicationSecurityGroup(),
+			"azurerm_availability_set":                           dataSourceArmAvailabilitySet(),
+			"azurerm_azuread_application":                        dataSourceArmAzureADApplication(),
+			"azurerm_azuread_service_principal":                  dataSourceArmActiveDirectoryServicePrincipal(),
+			"azurerm_batch_account":                              dataSourceArmBatchAccount(),
+			"azurerm_batch_certificate":                          dataSourceArmBatchCertificate(),
+			"azurerm_batch_pool":                                 dataSourceArmBatchPool(),
+			"azurerm_builtin_role_definition":                    dataSourceArmBuiltInRoleDefinition(),
+			"azurerm_cdn_profile":                                dataSourceArmCdnProfile(),
+			"azurerm_client_config":                              dataSourceArmClientConfig(),
+			"azurerm_container_registry":                         dataSourceArmContainerRegistry(),
+			"azurerm_cosmosdb_account":                           dataSourceArmCosmosDBAccount(),
+			"azurerm_data_lake_store":                            dataSourceArmDataLakeStoreAccount(),
+			"azurerm_dev_test_lab":                               dataSourceArmDevTestLab(),
+			"azurerm_dns_zone":                                   dataSourceArmDnsZone(),
+			"azurerm_eventhub_namespace":                         dataSourceEventHubNamespace(),
+			"azurerm_express_route_circuit":                      dataSourceArmExpressRouteCircuit(),
+			"azurerm_firewall":                                   dataSourceArmFirewall(),
+			"azurerm_firewall_fqdn_tags":                         dataSourceArmFirewallFqdnTags(),
+			"azurerm_image":                                      dataSourceArmImage(),
+			"azurerm_hdinsight_cluster":                          dataSourceArmHDInsightSparkCluster(),
+			"azurerm_key_vault_access_policy":                    dataSourceArmKeyVaultAccessPolicy(),
+			"azurerm_key_vault_key":                              dataSourceArmKeyVaultKey(),
+			"azurerm_key_vault_secret":                           dataSourceArmKeyVaultSecret(),
+			"azurerm_key_vault":                                  dataSourceArmKeyVault(),
+			"azurerm_kubernetes_cluster":                         dataSourceArmKubernetesCluster(),
+			"azurerm_lb":                                         dataSourceArmLoadBalancer(),
+			"azurerm_lb_backend_address_pool":                    dataSourceArmLoadBalancerBackendAddressPool(),
+			"azurerm_log_analytics_workspace":                    dataSourceLogAnalyticsWorkspace(),
+			"azurerm_logic_app_workflow":                         dataSourceArmLogicAppWorkflow(),
+			"azurerm_managed_disk":                               dataSourceArmManagedDisk(),
+			"azurerm_management_group":                           dataSourceArmManagementGroup(),
+			"azurerm_monitor_action_group":                       dataSourceArmMonitorActionGroup(),
+			"azurerm_monitor_diagnostic_categories":              dataSourceArmMonitorDiagnosticCategories(),
+			"azurerm_monitor_log_profile":                        dataSourceArmMonitorLogProfile(),
+			"azurerm_network_interface":                          dataSourceArmNetworkInterface(),
+			"azurerm_network_interface_effective_routes":         dataSourceArmNetworkInterfaceEffectiveRoutes(),
+			"azurerm_network_interface_effective_security_rules": dataSourceArmNetworkInterfaceEffectiveSecurityRules(),
+			"azurerm_network_security_group":                     dataSourceArmNetworkSecurityGroup(),
+			"azurerm_network_watcher":                            dataSourceArmNetworkWatcher(),
+			"azurerm_network_watcher_next_hop":                   dataSourceArmNetworkWatcherNextHop(),
+			"azurerm_notification_hub_namespace":                 dataSourceNotificationHubNamespace(),
+			"azurerm_notification_hub":                           dataSourceNotificationHub(),
+			"azurerm_platform_image":                             dataSourceArmPlatformImage(),
+			"azurerm_point_to_site_vpn_gateway_profile":          dataSourceArmPointToSiteVpnGatewayProfile(),
+			"azurerm_policy_definition":                          dataSourceArmPolicyDefinition(),
+			"azurerm_public_ip":                                  dataSourceArmPublicIP(),
+			"azurerm_public_ips":                                 dataSourceArmPublicIPs(),
+			"azurerm_recovery_services_vault":                    dataSourceArmRecoveryServicesVault(),
+			"azurerm_recovery_services_protection_policy_vm":     dataSourceArmRecoveryServicesProtectionPolicyVm(),
+			"azurerm_resource_group":                             dataSourceArmResourceGroup(),
+			"azurerm_role_definition":                            dataSourceArmRoleDefinition(),
+			"azurerm_route_table":                                dataSourceArmRouteTable(),
+			"azurerm_scheduler_job_collection":                   dataSourceArmSchedulerJobCollection(),
+			"azurerm_servicebus_namespace":                       dataSourceArmServiceBusNamespace(),
+			"azurerm_shared_image_gallery":                       dataSourceArmSharedImageGallery(),
+			"azurerm_shared_image_version":                       dataSourceArmSharedImageVersion(),
+			"azurerm_shared_image":                               dataSourceArmSharedImage(),
+			"azurerm_snapshot":                                   dataSourceArmSnapshot(),
+			"azurerm_stream_analytics_job":                       dataSourceArmStreamAnalyticsJob(),
+			"azurerm_storage_account_sas":                        dataSourceArmStorageAccountSharedAccessSignature(),
+			"azurerm_storage_account":                            dataSourceArmStorageAccount(),
+			"azurerm_subnet":                                     dataSourceArmSubnet(),
+			"azurerm_subscription":                               dataSourceArmSubscription(),
+			"azurerm_subscriptions":                              dataSourceArmSubscriptions(),
+			"azurerm_traffic_manager_geographical_location":      dataSourceArmTrafficManagerGeographicalLocation(),
+			"azurerm_virtual_hub":                                dataSourceArmVirtualHub(),
+			"azurerm_virtual_machine":                            dataSourceArmVirtualMachine(),
+			"azurerm_virtual_network_gateway":                    dataSourceArmVirtualNetworkGateway(),
+			"azurerm_virtual_network":                            dataSourceArmVirtualNetwork(),
+			"azurerm_virtual_network_ip_availability":            dataSourceArmVirtualNetworkIPAvailability(),
+			"azurerm_virtual_wan":                                dataSourceArmVirtualWan(),
+			"azurerm_vpn_site_configuration":                     dataSourceArmVpnSiteConfiguration(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -235,6 +320,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_data_lake_store_firewall_rule":          resourceArmDataLakeStoreFirewallRule(),
 			"azurerm_data_lake_store":                        resourceArmDataLakeStore(),
 			"azurerm_databricks_workspace":                   resourceArmDatabricksWorkspace(),
+			"azurerm_ddos_custom_policy":                     resourceArmDDoSCustomPolicy(),
 			"azurerm_ddos_protection_plan":                   resourceArmDDoSProtectionPlan(),
 			"azurerm_dev_test_lab":                           resourceArmDevTestLab(),
 			"azurerm_dev_test_linux_virtual_machine":         resourceArmDevTestLinuxVirtualMachine(),
@@ -263,7 +349,11 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_express_route_circuit_authorization":    resourceArmExpressRouteCircuitAuthorization(),
 			"azurerm_express_route_circuit_peering":          resourceArmExpressRouteCircuitPeering(),
 			"azurerm_express_route_circuit":                  resourceArmExpressRouteCircuit(),
+			"azurerm_express_route_connection":               resourceArmExpressRouteConnection(),
+			"azurerm_express_route_gateway":                  resourceArmExpressRouteGateway(),
+			"azurerm_express_route_port":                     resourceArmExpressRoutePort(),
 			"azurerm_firewall_application_rule_collection":   resourceArmFirewallApplicationRuleCollection(),
+			"azurerm_firewall_nat_rule_collection":           resourceArmFirewallNatRuleCollection(),
 			"azurerm_firewall_network_rule_collection":       resourceArmFirewallNetworkRuleCollection(),
 			"azurerm_firewall":                               resourceArmFirewall(),
 			"azurerm_function_app":                           resourceArmFunctionApp(),
@@ -276,6 +366,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_hdinsight_spark_cluster":                resourceArmHDInsightSparkCluster(),
 			"azurerm_hdinsight_storm_cluster":                resourceArmHDInsightStormCluster(),
 			"azurerm_image":                                  resourceArmImage(),
+			"azurerm_interface_endpoint":                     resourceArmInterfaceEndpoint(),
 			"azurerm_iothub_consumer_group":                  resourceArmIotHubConsumerGroup(),
 			"azurerm_iothub":                                 resourceArmIotHub(),
 			"azurerm_iothub_shared_access_policy":            resourceArmIotHubSharedAccessPolicy(),
@@ -329,15 +420,20 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_network_interface_application_security_group_association":               resourceArmNetworkInterfaceApplicationSecurityGroupAssociation(),
 			"azurerm_network_interface_backend_address_pool_association":                     resourceArmNetworkInterfaceBackendAddressPoolAssociation(),
 			"azurerm_network_interface_nat_rule_association":                                 resourceArmNetworkInterfaceNatRuleAssociation(),
+			"azurerm_network_interface_virtual_network_tap_association":                      resourceArmNetworkInterfaceVirtualNetworkTapAssociation(),
 			"azurerm_network_interface":                                                      resourceArmNetworkInterface(),
 			"azurerm_network_packet_capture":                                                 resourceArmNetworkPacketCapture(),
+			"azurerm_network_profile":                                                        resourceArmNetworkProfile(),
 			"azurerm_network_security_group":                                                 resourceArmNetworkSecurityGroup(),
 			"azurerm_network_security_rule":                                                  resourceArmNetworkSecurityRule(),
 			"azurerm_network_watcher":                                                        resourceArmNetworkWatcher(),
+			"azurerm_network_watcher_flow_log":                                               resourceArmNetworkWatcherFlowLog(),
 			"azurerm_notification_hub_authorization_rule":                                    resourceArmNotificationHubAuthorizationRule(),
 			"azurerm_notification_hub_namespace":                                             resourceArmNotificationHubNamespace(),
 			"azurerm_notification_hub":                                                       resourceArmNotificationHub(),
 			"azurerm_packet_capture":                                                         resourceArmPacketCapture(),
+			"azurerm_point_to_site_vpn_gateway":                                              resourceArmPointToSiteVpnGateway(),
+			"azurerm_point_to_site_vpn_server_configuration":                                 resourceArmPointToSiteVpnServerConfiguration(),
 			"azurerm_policy_assignment":                                                      resourceArmPolicyAssignment(),
 			"azurerm_policy_definition":                                                      resourceArmPolicyDefinition(),
 			"azurerm_policy_set_definition":                                                  resourceArmPolicySetDefinition(),
@@ -357,6 +453,8 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_resource_group":                                                         resourceArmResourceGroup(),
 			"azurerm_role_assignment":                                                        resourceArmRoleAssignment(),
 			"azurerm_role_definition":                                                        resourceArmRoleDefinition(),
+			"azurerm_route_filter":                                                           resourceArmRouteFilter(),
+			"azurerm_route_filter_rule":                                                      resourceArmRouteFilterRule(),
 			"azurerm_route_table":                                                            resourceArmRouteTable(),
 			"azurerm_route":                                                                  resourceArmRoute(),
 			"azurerm_scheduler_job_collection":                                               resourceArmSchedulerJobCollection(),
@@ -401,11 +499,14 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_stream_analytics_stream_input_iothub":                                   resourceArmStreamAnalyticsStreamInputIoTHub(),
 			"azurerm_subnet_network_security_group_association":                              resourceArmSubnetNetworkSecurityGroupAssociation(),
 			"azurerm_subnet_route_table_association":                                         resourceArmSubnetRouteTableAssociation(),
+			"azurerm_subnet_service_endpoint_storage_policy":                                 resourceArmSubnetServiceEndpointStoragePolicy(),
 			"azurerm_subnet":                                                                 resourceArmSubnet(),
 			"azurerm_template_deployment":                                                    resourceArmTemplateDeployment(),
 			"azurerm_traffic_manager_endpoint":                                               resourceArmTrafficManagerEndpoint(),
 			"azurerm_traffic_manager_profile":                                                resourceArmTrafficManagerProfile(),
 			"azurerm_user_assigned_identity":                                                 resourceArmUserAssignedIdentity(),
+			"azurerm_virtual_hub":                                                            resourceArmVirtualHub(),
+			"azurerm_virtual_hub_connection":                                                 resourceArmVirtualHubConnection(),
 			"azurerm_virtual_machine_data_disk_attachment":                                   resourceArmVirtualMachineDataDiskAttachment(),
 			"azurerm_virtual_machine_extension":                                              resourceArmVirtualMachineExtensions(),
 			"azurerm_virtual_machine_scale_set":                                              resourceArmVirtualMachineScaleSet(),
@@ -413,10 +514,26 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_virtual_network_gateway_connection":                                     resourceArmVirtualNetworkGatewayConnection(),
 			"azurerm_virtual_network_gateway":                                                resourceArmVirtualNetworkGateway(),
 			"azurerm_virtual_network_peering":                                                resourceArmVirtualNetworkPeering(),
+			"azurerm_virtual_network_tap":                                                    resourceArmVirtualNetworkTap(),
 			"azurerm_virtual_network":                                                        resourceArmVirtualNetwork(),
+			"azurerm_virtual_wan":                                                            resourceArmVirtualWan(),
+			"azurerm_vpn_gateway":                                                            resourceArmVpnGateway(),
+			"azurerm_vpn_gateway_connection":                                                 resourceArmVpnGatewayConnection(),
+			"azurerm_vpn_site":                                                               resourceArmVpnSite(),
+			"azurerm_web_application_firewall_policy":                                        resourceArmWebApplicationFirewallPolicy(),
 		},
 	}
 
+	// Resource Providers are registered as they're required by the resources being created, rather than up-front
+	for name, resource := range p.ResourcesMap {
+		resource.Create = withResourceProviderRegistration(name, resource)
+
+		// the Default Tags specified in the Provider block are merged into the tags of each resource
+		if resourceSupportsDefaultTags(resource) {
+			withDefaultTags(resource)
+		}
+	}
+
 	p.ConfigureFunc = providerConfigure(p)
 
 	return p
@@ -448,17 +565,30 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 
 		partnerId := d.Get("partner_id").(string)
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
-		client, err := getArmClient(config, skipProviderRegistration, partnerId)
+		senderOptions := azure.SenderOptions{
+			MaxRetries:     d.Get("max_retries").(int),
+			MaxRetryDelay:  time.Duration(d.Get("max_retry_delay_in_seconds").(int)) * time.Second,
+			LogSummaryOnly: d.Get("log_request_summary_only").(bool),
+		}
+		auxiliaryTenantIds := expandProviderStringList(d, "auxiliary_tenant_ids", "ARM_AUXILIARY_TENANT_IDS")
+		env, err := loadEnvironment(config.Environment, d.Get("metadata_host").(string), d.Get("environment_file").(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error determining the Azure Environment: %+v", err)
+		}
+
+		client, err := getArmClient(config, env, skipProviderRegistration, partnerId, senderOptions, auxiliaryTenantIds)
 
 		if err != nil {
 			return nil, err
 		}
 
 		client.StopContext = p.StopContext()
+		client.defaultTags = d.Get("default_tags").(map[string]interface{})
+		client.ignoreTagPrefixes = expandProviderStringList(d, "ignore_tag_prefixes", "ARM_IGNORE_TAG_PREFIXES")
 
 		// replaces the context between tests
 		p.MetaReset = func() error {
-			client.StopContext = p.StopContext()
+			client.setStopContext(p.StopContext())
 			return nil
 		}
 
@@ -467,22 +597,20 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			// List all the available providers and their registration state to avoid unnecessary
 			// requests. This also lets us check if the provider credentials are correct.
 			ctx := client.StopContext
-			providerList, err := client.providersClient.List(ctx, nil, "")
+			providerList, err := client.resources().providersClient.List(ctx, nil, "")
 			if err != nil {
 				return nil, fmt.Errorf("Unable to list provider registration status, it is possible that this is due to invalid "+
 					"credentials or the service principal does not have permission to use the Resource Manager API, Azure "+
 					"error: %s", err)
 			}
 
-			if !skipProviderRegistration {
-				availableResourceProviders := providerList.Values()
-				requiredResourceProviders := requiredResourceProviders()
+			// cache the registration state, so that it's not looked up again when resources are created
+			client.resourceProviders.available = providerList.Values()
+		}
 
-				err := ensureResourceProvidersAreRegistered(ctx, client.providersClient, availableResourceProviders, requiredResourceProviders)
-				if err != nil {
-					return nil, fmt.Errorf("Error ensuring Resource Providers are registered: %s", err)
-				}
-			}
+		resourceProvidersToRegister := expandProviderStringList(d, "resource_providers_to_register", "ARM_RESOURCE_PROVIDERS_TO_REGISTER")
+		if err := client.ensureResourceProvidersRegistered(client.StopContext, resourceProvidersToRegister); err != nil {
+			return nil, err
 		}
 
 		return client, nil
@@ -543,3 +671,56 @@ func isBase64Encoded(data string) bool {
 	_, err := base64.StdEncoding.DecodeString(data)
 	return err == nil
 }
+
+// expandProviderStringList returns the values of the specified list/set in the Provider block - falling back to the
+// semicolon-separated list in the specified Environment Variable (since lists can't be defaulted)
+func expandProviderStringList(d *schema.ResourceData, key string, envVar string) []string {
+	raw := d.Get(key)
+	if set, ok := raw.(*schema.Set); ok {
+		raw = set.List()
+	}
+
+	values := make([]string, 0)
+	for _, v := range raw.([]interface{}) {
+		values = append(values, v.(string))
+	}
+
+	if len(values) == 0 {
+		for _, v := range strings.Split(os.Getenv(envVar), ";") {
+			if value := strings.TrimSpace(v); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+
+	return values
+}
+
+// withResourceProviderRegistration ensures the Resource Providers required by the resource are registered
+// within the Subscription the resource is being created in, prior to creating it - both of which count
+// towards the resource's Create timeout
+func withResourceProviderRegistration(resourceName string, resource *schema.Resource) schema.CreateFunc {
+	resourceProviders, _ := resourceProvidersForResource(resourceName)
+	supportsSubscriptionIdOverride := azure.IsSchemaSubscriptionIdOverride(resource.Schema["subscription_id"])
+	create := resource.Create
+
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client := meta.(*ArmClient)
+		if supportsSubscriptionIdOverride {
+			var err error
+			client, err = client.clientForResource(d)
+			if err != nil {
+				return err
+			}
+		}
+
+		ctx, cancel := timeouts.StartCreate(client.StopContext, d)
+		defer cancel()
+
+		if err := client.ensureResourceProvidersRegistered(ctx, resourceProviders); err != nil {
+			return fmt.Errorf("Error registering the Resource Providers required by %q: %+v", resourceName, err)
+		}
+
+		return create(d, meta)
+	}
+}
@@ -0,0 +1,186 @@
+package azurerm
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourcesExemptFromRequiringImport are resources which can't (and don't need to) check for an existing resource
+// prior to creation - each entry must explain why
+var resourcesExemptFromRequiringImport = map[string]string{
+	"azurerm_app_service_active_slot": "this resource swaps slots and has nothing to delete, as such it can't destroy an existing resource",
+	"azurerm_azuread_application":     "the Object ID is generated by the service and the Name isn't unique, as such there's nothing to check prior to creation",
+}
+
+// maxRequiresImportCallDepth is how many function calls deep from the Create function we'll look for the check,
+// since some resources share their Create function (e.g. Logic App Actions & Triggers)
+const maxRequiresImportCallDepth = 3
+
+func TestProvider_resourcesRequireImport(t *testing.T) {
+	functions, constructors := parseProviderSourceForRequiresImport(t)
+	provider := Provider().(*schema.Provider)
+
+	for resourceName := range provider.ResourcesMap {
+		t.Run(resourceName, func(t *testing.T) {
+			if _, exempt := resourcesExemptFromRequiringImport[resourceName]; exempt {
+				t.Skipf("Resource %q is exempt from requiring import", resourceName)
+			}
+
+			constructorName, ok := constructors[resourceName]
+			if !ok {
+				t.Fatalf("Unable to find the function defining Resource %q in the ResourcesMap", resourceName)
+			}
+
+			constructor, ok := functions[constructorName]
+			if !ok {
+				t.Fatalf("Unable to find the function %q defining Resource %q", constructorName, resourceName)
+			}
+
+			createName := findResourceCreateFunctionName(constructor)
+			if createName == "" {
+				t.Fatalf("Unable to find the Create function for Resource %q in %q", resourceName, constructorName)
+			}
+
+			if !functionReferencesImportAsExistsError(functions, createName, maxRequiresImportCallDepth, map[string]bool{}) {
+				t.Fatalf("The Create function %q for Resource %q doesn't check for an existing resource (using `tf.ImportAsExistsError`)", createName, resourceName)
+			}
+		})
+	}
+}
+
+func TestProvider_resourcesExemptFromRequiringImportExist(t *testing.T) {
+	provider := Provider().(*schema.Provider)
+
+	for resourceName, reason := range resourcesExemptFromRequiringImport {
+		if _, ok := provider.ResourcesMap[resourceName]; !ok {
+			t.Fatalf("Resource %q is exempt from requiring import but isn't defined in the ResourcesMap", resourceName)
+		}
+
+		if reason == "" {
+			t.Fatalf("Resource %q is exempt from requiring import but no reason was given", resourceName)
+		}
+	}
+}
+
+// parseProviderSourceForRequiresImport parses the source of this package, returning the top-level functions
+// by name and the name of the function defining each resource in the ResourcesMap
+func parseProviderSourceForRequiresImport(t *testing.T) (map[string]*ast.FuncDecl, map[string]string) {
+	fset := token.NewFileSet()
+	packages, err := parser.ParseDir(fset, ".", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatalf("Error parsing the Provider source: %+v", err)
+	}
+
+	pkg, ok := packages["azurerm"]
+	if !ok {
+		t.Fatalf("Unable to find the `azurerm` package")
+	}
+
+	functions := make(map[string]*ast.FuncDecl)
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil {
+				functions[fn.Name.Name] = fn
+			}
+		}
+	}
+
+	providerFunc, ok := functions["Provider"]
+	if !ok {
+		t.Fatalf("Unable to find the `Provider` function")
+	}
+
+	constructors := make(map[string]string)
+	ast.Inspect(providerFunc, func(n ast.Node) bool {
+		kv, ok := n.(*ast.KeyValueExpr)
+		if !ok {
+			return true
+		}
+
+		key, ok := kv.Key.(*ast.BasicLit)
+		if !ok || key.Kind != token.STRING {
+			return true
+		}
+
+		call, ok := kv.Value.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		if fun, ok := call.Fun.(*ast.Ident); ok {
+			constructors[strings.Trim(key.Value, "\"")] = fun.Name
+		}
+		return true
+	})
+
+	return functions, constructors
+}
+
+func findResourceCreateFunctionName(constructor *ast.FuncDecl) string {
+	name := ""
+	ast.Inspect(constructor, func(n ast.Node) bool {
+		kv, ok := n.(*ast.KeyValueExpr)
+		if !ok || name != "" {
+			return name == ""
+		}
+
+		if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "Create" {
+			if value, ok := kv.Value.(*ast.Ident); ok {
+				name = value.Name
+			}
+		}
+		return true
+	})
+
+	return name
+}
+
+// functionReferencesImportAsExistsError determines if the specified function (or a function in this package
+// which it calls, up to `depth` calls deep) references `tf.ImportAsExistsError`
+func functionReferencesImportAsExistsError(functions map[string]*ast.FuncDecl, name string, depth int, visited map[string]bool) bool {
+	fn, ok := functions[name]
+	if !ok || visited[name] {
+		return false
+	}
+	visited[name] = true
+
+	found := false
+	called := make([]string, 0)
+	ast.Inspect(fn, func(n ast.Node) bool {
+		switch v := n.(type) {
+		case *ast.SelectorExpr:
+			if pkg, ok := v.X.(*ast.Ident); ok && pkg.Name == "tf" && v.Sel.Name == "ImportAsExistsError" {
+				found = true
+			}
+		case *ast.CallExpr:
+			if fun, ok := v.Fun.(*ast.Ident); ok {
+				called = append(called, fun.Name)
+			}
+		}
+		return !found
+	})
+
+	if found {
+		return true
+	}
+
+	if depth == 0 {
+		return false
+	}
+
+	for _, c := range called {
+		if functionReferencesImportAsExistsError(functions, c, depth-1, visited) {
+			return true
+		}
+	}
+
+	return false
+}
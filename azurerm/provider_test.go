@@ -1,9 +1,14 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/Azure/go-autorest/autorest/azure"
@@ -11,6 +16,8 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/recording"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
@@ -33,7 +40,112 @@ func TestProvider_impl(t *testing.T) {
 	var _ = Provider()
 }
 
+func TestProvider_resourcesSupportCustomTimeouts(t *testing.T) {
+	provider := Provider().(*schema.Provider)
+
+	for resourceName, resource := range provider.ResourcesMap {
+		t.Run(resourceName, func(t *testing.T) {
+			if resource.Timeouts == nil {
+				t.Fatalf("Resource %q has no Timeouts defined", resourceName)
+			}
+
+			if resource.Timeouts.Create == nil {
+				t.Fatalf("Resource %q doesn't define a Create timeout", resourceName)
+			}
+
+			if resource.Timeouts.Read == nil {
+				t.Fatalf("Resource %q doesn't define a Read timeout", resourceName)
+			}
+
+			if resource.Timeouts.Delete == nil {
+				t.Fatalf("Resource %q doesn't define a Delete timeout", resourceName)
+			}
+
+			if resource.Update != nil && resource.Timeouts.Update == nil {
+				t.Fatalf("Resource %q supports Update but doesn't define an Update timeout", resourceName)
+			}
+		})
+	}
+}
+
+func TestProvider_resourceProviderRegistrationSubscription(t *testing.T) {
+	testData := []struct {
+		resourceName            string
+		resource                *schema.Resource
+		expectOtherSubscription bool
+	}{
+		{
+			// `subscription_id` is the ID of the API Management Subscription, rather than an Azure Subscription
+			resourceName:            "azurerm_api_management_subscription",
+			resource:                resourceArmApiManagementSubscription(),
+			expectOtherSubscription: false,
+		},
+		{
+			resourceName:            "azurerm_dns_zone",
+			resource:                resourceArmDnsZone(),
+			expectOtherSubscription: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.resourceName, func(t *testing.T) {
+			client := &ArmClient{
+				StopContext:              context.Background(),
+				subscriptionId:           "00000000-0000-0000-0000-000000000000",
+				skipProviderRegistration: true,
+				session: &armSession{
+					endpoint:            "https://management.azure.com/",
+					subscriptionClients: make(map[string]*subscriptionClient),
+				},
+			}
+
+			created := false
+			v.resource.Create = func(d *schema.ResourceData, meta interface{}) error {
+				created = true
+				return nil
+			}
+			create := withResourceProviderRegistration(v.resourceName, v.resource)
+
+			d := v.resource.TestResourceData()
+			if err := d.Set("subscription_id", "aaaaaaaa-1111-1111-1111-111111111111"); err != nil {
+				t.Fatalf("Error setting `subscription_id`: %+v", err)
+			}
+
+			if err := create(d, client); err != nil {
+				t.Fatalf("Error creating %q: %+v", v.resourceName, err)
+			}
+			if !created {
+				t.Fatalf("Expected %q to be created", v.resourceName)
+			}
+
+			usedOtherSubscription := len(client.session.subscriptionClients) > 0
+			if usedOtherSubscription != v.expectOtherSubscription {
+				t.Fatalf("Expected a client for another Subscription to be used to be %t but got %t", v.expectOtherSubscription, usedOtherSubscription)
+			}
+		})
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
+	session := testAccRecordingSession(t)
+	if session != nil && session.Mode() == recording.ModePlayback {
+		testAccSkipIfNotRecorded(t, session)
+
+		// no credentials are needed to play back a test, however the locations need to match those recorded
+		for _, variable := range []string{"ARM_TEST_LOCATION", "ARM_TEST_LOCATION_ALT"} {
+			recorded, err := session.Variable(t.Name(), variable, nil)
+			if err != nil {
+				t.Fatalf("Error playing back %q: %+v", t.Name(), err)
+			}
+
+			if value := os.Getenv(variable); !strings.EqualFold(value, recorded) {
+				t.Fatalf("`%s` must be set to %q (the value used when recording) to play back %q but got %q", variable, recorded, t.Name(), value)
+			}
+		}
+
+		return
+	}
+
 	variables := []string{
 		"ARM_CLIENT_ID",
 		"ARM_CLIENT_SECRET",
@@ -49,6 +161,117 @@ func testAccPreCheck(t *testing.T) {
 			t.Fatalf("`%s` must be set for acceptance tests!", variable)
 		}
 	}
+
+	if session != nil {
+		for _, variable := range []string{"ARM_TEST_LOCATION", "ARM_TEST_LOCATION_ALT"} {
+			if _, err := session.Variable(t.Name(), variable, func() string { return os.Getenv(variable) }); err != nil {
+				t.Fatalf("Error recording %q: %+v", t.Name(), err)
+			}
+		}
+	}
+}
+
+var (
+	testAccRecording     *recording.Session
+	testAccRecordingErr  error
+	testAccRecordingOnce sync.Once
+)
+
+// testAccRecordingSession returns the Session used to record (or play back) the HTTP Interactions of the Acceptance
+// Tests when `ARM_TEST_RECORDING_MODE` is set to `record` (or `playback`) - or nil when the tests are run live.
+// Cassettes are stored in the directory specified in `ARM_TEST_RECORDING_DIR`, which defaults to `testdata/recordings`.
+func testAccRecordingSession(t *testing.T) *recording.Session {
+	testAccRecordingOnce.Do(func() {
+		mode, err := recording.ParseMode(os.Getenv("ARM_TEST_RECORDING_MODE"))
+		if err != nil {
+			testAccRecordingErr = err
+			return
+		}
+
+		if mode == recording.ModeLive {
+			return
+		}
+
+		directory := os.Getenv("ARM_TEST_RECORDING_DIR")
+		if directory == "" {
+			directory = filepath.Join("testdata", "recordings")
+		}
+
+		session, err := recording.NewSession(mode, directory)
+		if err != nil {
+			testAccRecordingErr = err
+			return
+		}
+
+		placeholders := map[string]string{
+			"ARM_SUBSCRIPTION_ID": recording.PlaceholderSubscriptionId,
+			"ARM_TENANT_ID":       recording.PlaceholderTenantId,
+			"ARM_CLIENT_ID":       recording.PlaceholderClientId,
+		}
+		for variable, placeholder := range placeholders {
+			if mode == recording.ModeRecord {
+				session.AddSubstitution(os.Getenv(variable), placeholder)
+				continue
+			}
+
+			// the Provider authenticates against the in-process server when playing back
+			os.Setenv(variable, placeholder)
+		}
+		if mode == recording.ModePlayback {
+			os.Setenv("ARM_CLIENT_SECRET", recording.PlaceholderClientSecret)
+		}
+
+		recording.SetCurrentSession(session)
+		testAccRecording = session
+	})
+
+	if testAccRecordingErr != nil {
+		t.Fatalf("Error configuring the Recording Session: %+v", testAccRecordingErr)
+	}
+
+	return testAccRecording
+}
+
+// testAccSkipIfNotRecorded skips tests which haven't been recorded, since these can't be played back - unless
+// running in CI (where `CI` is set), where the tests being played back are expected to have been recorded
+func testAccSkipIfNotRecorded(t *testing.T, session *recording.Session) {
+	if session.HasCassette(t.Name()) {
+		return
+	}
+
+	if os.Getenv("CI") != "" {
+		t.Fatalf("%q hasn't been recorded, so can't be played back in CI", t.Name())
+	}
+
+	t.Skipf("Skipping since %q hasn't been recorded", t.Name())
+}
+
+// testAccRandTimeInt returns a random integer used to name the resources within an Acceptance Test - which is stored
+// in the test's Cassette when recording, and read from it when playing back (such that the same requests are made)
+func testAccRandTimeInt(t *testing.T) int {
+	session := testAccRecordingSession(t)
+	if session == nil {
+		return tf.AccRandTimeInt()
+	}
+
+	if session.Mode() == recording.ModePlayback {
+		testAccSkipIfNotRecorded(t, session)
+	}
+
+	value, err := session.Variable(t.Name(), "random_int", func() string {
+		return strconv.Itoa(tf.AccRandTimeInt())
+	})
+	if err != nil {
+		t.Fatalf("Error determining the random integer for %q: %+v", t.Name(), err)
+	}
+
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		t.Fatalf("Error parsing the random integer %q for %q: %+v", value, t.Name(), err)
+	}
+
+	session.Attribute(t.Name(), value)
+	return i
 }
 
 func testLocation() string {
@@ -70,7 +293,7 @@ func testArmEnvironmentName() string {
 
 func testArmEnvironment() (*azure.Environment, error) {
 	envName := testArmEnvironmentName()
-	return authentication.DetermineEnvironment(envName)
+	return loadEnvironment(envName, os.Getenv("ARM_METADATA_HOST"), os.Getenv("ARM_ENVIRONMENT_FILE"))
 }
 
 func testGetAzureConfig(t *testing.T) *authentication.Config {
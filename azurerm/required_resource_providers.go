@@ -2,7 +2,10 @@ package azurerm
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
+	"sync"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
 	"github.com/hashicorp/go-azure-helpers/resourceproviders"
@@ -19,6 +22,7 @@ func requiredResourceProviders() map[string]struct{} {
 		"Microsoft.ApiManagement":        {},
 		"Microsoft.Authorization":        {},
 		"Microsoft.Automation":           {},
+		"Microsoft.Batch":                {},
 		"Microsoft.Cache":                {},
 		"Microsoft.Cdn":                  {},
 		"Microsoft.CognitiveServices":    {},
@@ -27,8 +31,10 @@ func requiredResourceProviders() map[string]struct{} {
 		"Microsoft.ContainerRegistry":    {},
 		"Microsoft.ContainerService":     {},
 		"Microsoft.Databricks":           {},
+		"Microsoft.DataFactory":          {},
 		"Microsoft.DataLakeAnalytics":    {},
 		"Microsoft.DataLakeStore":        {},
+		"Microsoft.DBforMariaDB":         {},
 		"Microsoft.DBforMySQL":           {},
 		"Microsoft.DBforPostgreSQL":      {},
 		"Microsoft.Devices":              {},
@@ -56,6 +62,7 @@ func requiredResourceProviders() map[string]struct{} {
 		"Microsoft.Security":             {},
 		"Microsoft.ServiceBus":           {},
 		"Microsoft.ServiceFabric":        {},
+		"Microsoft.SignalRService":       {},
 		"Microsoft.Sql":                  {},
 		"Microsoft.Storage":              {},
 		"Microsoft.StreamAnalytics":      {},
@@ -63,6 +70,168 @@ func requiredResourceProviders() map[string]struct{} {
 	}
 }
 
+// resourceProvidersForResources maps the prefix of a resource's name to the Resource Providers which need to be registered
+// within a Subscription to provision it - new resources are matched using the longest matching prefix
+// NOTE: Resource Providers in this list are case sensitive and must also be present in `requiredResourceProviders`
+var resourceProvidersForResources = map[string][]string{
+	"azurerm_api_management":             {"Microsoft.ApiManagement"},
+	"azurerm_app_service":                {"Microsoft.Web"},
+	"azurerm_application_gateway":        {"Microsoft.Network"},
+	"azurerm_application_insights":       {"microsoft.insights"},
+	"azurerm_application_security_group": {"Microsoft.Network"},
+	"azurerm_automation":                 {"Microsoft.Automation"},
+	"azurerm_autoscale_setting":          {"microsoft.insights"},
+	"azurerm_availability_set":           {"Microsoft.Compute"},
+	"azurerm_azuread":                    {}, // Azure Active Directory isn't a Resource Provider
+	"azurerm_batch":                      {"Microsoft.Batch"},
+	"azurerm_cdn":                        {"Microsoft.Cdn"},
+	"azurerm_cognitive":                  {"Microsoft.CognitiveServices"},
+	"azurerm_connection_monitor":         {"Microsoft.Network"},
+	"azurerm_container_group":            {"Microsoft.ContainerInstance"},
+	"azurerm_container_registry":         {"Microsoft.ContainerRegistry"},
+	"azurerm_container_service":          {"Microsoft.ContainerService"},
+	"azurerm_cosmosdb":                   {"Microsoft.DocumentDB"},
+	"azurerm_data_factory":               {"Microsoft.DataFactory"},
+	"azurerm_data_lake_analytics":        {"Microsoft.DataLakeAnalytics"},
+	"azurerm_data_lake_store":            {"Microsoft.DataLakeStore"},
+	"azurerm_databricks":                 {"Microsoft.Databricks"},
+	"azurerm_ddos_custom_policy":         {"Microsoft.Network"},
+	"azurerm_ddos_protection_plan":       {"Microsoft.Network"},
+	"azurerm_dev_test":                   {"Microsoft.DevTestLab"},
+	"azurerm_devspace":                   {"Microsoft.DevSpaces"},
+	"azurerm_dns":                        {"Microsoft.Network"},
+	"azurerm_eventgrid":                  {"Microsoft.EventGrid"},
+	"azurerm_eventhub":                   {"Microsoft.EventHub"},
+	"azurerm_express_route":              {"Microsoft.Network"},
+	"azurerm_firewall":                   {"Microsoft.Network"},
+	"azurerm_function_app":               {"Microsoft.Web"},
+	"azurerm_hdinsight":                  {"Microsoft.HDInsight"},
+	"azurerm_image":                      {"Microsoft.Compute"},
+	"azurerm_interface_endpoint":         {"Microsoft.Network"},
+	"azurerm_iothub":                     {"Microsoft.Devices"},
+	"azurerm_key_vault":                  {"Microsoft.KeyVault"},
+	"azurerm_kubernetes":                 {"Microsoft.ContainerService"},
+	"azurerm_lb":                         {"Microsoft.Network"},
+	"azurerm_local_network_gateway":      {"Microsoft.Network"},
+	"azurerm_log_analytics":              {"Microsoft.OperationalInsights"},
+	"azurerm_log_analytics_solution":     {"Microsoft.OperationalInsights", "Microsoft.OperationsManagement"},
+	"azurerm_logic_app":                  {"Microsoft.Logic"},
+	"azurerm_managed_disk":               {"Microsoft.Compute"},
+	"azurerm_management_group":           {"Microsoft.Management"},
+	"azurerm_management_lock":            {"Microsoft.Authorization"},
+	"azurerm_mariadb":                    {"Microsoft.DBforMariaDB"},
+	"azurerm_media_services":             {"Microsoft.Media"},
+	"azurerm_metric_alertrule":           {"microsoft.insights"},
+	"azurerm_monitor":                    {"microsoft.insights"},
+	"azurerm_mssql":                      {"Microsoft.Sql"},
+	"azurerm_mysql":                      {"Microsoft.DBforMySQL"},
+	"azurerm_network":                    {"Microsoft.Network"},
+	"azurerm_notification_hub":           {"Microsoft.NotificationHubs"},
+	"azurerm_packet_capture":             {"Microsoft.Network"},
+	"azurerm_point_to_site_vpn":          {"Microsoft.Network"},
+	"azurerm_policy":                     {"Microsoft.Authorization"},
+	"azurerm_postgresql":                 {"Microsoft.DBforPostgreSQL"},
+	"azurerm_public_ip":                  {"Microsoft.Network"},
+	"azurerm_recovery_services":          {"Microsoft.RecoveryServices"},
+	"azurerm_redis":                      {"Microsoft.Cache"},
+	"azurerm_relay":                      {"Microsoft.Relay"},
+	"azurerm_resource_group":             {"Microsoft.Resources"},
+	"azurerm_role":                       {"Microsoft.Authorization"},
+	"azurerm_route":                      {"Microsoft.Network"},
+	"azurerm_scheduler":                  {"Microsoft.Scheduler"},
+	"azurerm_search":                     {"Microsoft.Search"},
+	"azurerm_security_center":            {"Microsoft.Security"},
+	"azurerm_service_fabric":             {"Microsoft.ServiceFabric"},
+	"azurerm_servicebus":                 {"Microsoft.ServiceBus"},
+	"azurerm_shared_image":               {"Microsoft.Compute"},
+	"azurerm_signalr":                    {"Microsoft.SignalRService"},
+	"azurerm_snapshot":                   {"Microsoft.Compute"},
+	"azurerm_sql":                        {"Microsoft.Sql"},
+	"azurerm_storage":                    {"Microsoft.Storage"},
+	"azurerm_stream_analytics":           {"Microsoft.StreamAnalytics"},
+	"azurerm_subnet":                     {"Microsoft.Network"},
+	"azurerm_template_deployment":        {"Microsoft.Resources"},
+	"azurerm_traffic_manager":            {"Microsoft.Network"},
+	"azurerm_user_assigned_identity":     {"Microsoft.ManagedIdentity"},
+	"azurerm_virtual_hub":                {"Microsoft.Network"},
+	"azurerm_virtual_machine":            {"Microsoft.Compute"},
+	"azurerm_virtual_network":            {"Microsoft.Network"},
+	"azurerm_virtual_wan":                {"Microsoft.Network"},
+	"azurerm_vpn":                        {"Microsoft.Network"},
+	"azurerm_web_application_firewall":   {"Microsoft.Network"},
+}
+
+// resourceProvidersForResource returns the Resource Providers which need to be registered to provision the specified
+// resource, and whether the resource is known - using the longest prefix in `resourceProvidersForResources` which matches
+func resourceProvidersForResource(resourceName string) ([]string, bool) {
+	longestPrefix := ""
+	for prefix := range resourceProvidersForResources {
+		if strings.HasPrefix(resourceName, prefix) && len(prefix) > len(longestPrefix) {
+			longestPrefix = prefix
+		}
+	}
+
+	if longestPrefix == "" {
+		return nil, false
+	}
+
+	return resourceProvidersForResources[longestPrefix], true
+}
+
+// resourceProviderRegistrations tracks which Resource Providers are known to be registered within a Subscription
+type resourceProviderRegistrations struct {
+	lock       sync.Mutex
+	available  []resources.Provider
+	registered map[string]struct{}
+}
+
+// ensureResourceProvidersRegistered registers any of the specified Resource Providers which aren't yet registered within
+// the Subscription this client is bound to - the registration state is only looked up once per Subscription, and each
+// Resource Provider is checked at most once - unless Provider Registration has been skipped
+func (c *ArmClient) ensureResourceProvidersRegistered(ctx context.Context, resourceProviders []string) error {
+	if c.skipProviderRegistration || len(resourceProviders) == 0 {
+		return nil
+	}
+
+	c.resourceProviders.lock.Lock()
+	defer c.resourceProviders.lock.Unlock()
+
+	requiredRPs := make(map[string]struct{})
+	for _, rp := range resourceProviders {
+		if _, ok := c.resourceProviders.registered[rp]; !ok {
+			requiredRPs[rp] = struct{}{}
+		}
+	}
+
+	if len(requiredRPs) == 0 {
+		return nil
+	}
+
+	if c.resourceProviders.available == nil {
+		providerList, err := c.resources().providersClient.List(ctx, nil, "")
+		if err != nil {
+			return fmt.Errorf("Error listing the Resource Providers for Subscription %q: %+v", c.subscriptionId, err)
+		}
+
+		c.resourceProviders.available = providerList.Values()
+	}
+
+	if err := ensureResourceProvidersAreRegistered(ctx, c.resources().providersClient, c.resourceProviders.available, requiredRPs); err != nil {
+		return fmt.Errorf("Error ensuring Resource Providers are registered in Subscription %q: %+v\n\n"+
+			"If you don't have permission to register Resource Providers, they can be registered by an administrator "+
+			"and `skip_provider_registration` set to `true` in the Provider block", c.subscriptionId, err)
+	}
+
+	if c.resourceProviders.registered == nil {
+		c.resourceProviders.registered = make(map[string]struct{})
+	}
+	for rp := range requiredRPs {
+		c.resourceProviders.registered[rp] = struct{}{}
+	}
+
+	return nil
+}
+
 func ensureResourceProvidersAreRegistered(ctx context.Context, client resources.ProvidersClient, availableRPs []resources.Provider, requiredRPs map[string]struct{}) error {
 	log.Printf("[DEBUG] Determining which Resource Providers require Registration")
 	providersToRegister := resourceproviders.DetermineResourceProvidersRequiringRegistration(availableRPs, requiredRPs)
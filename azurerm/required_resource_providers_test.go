@@ -1,10 +1,13 @@
 package azurerm
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/go-azure-helpers/resourceproviders"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 )
 
 func TestAccAzureRMEnsureRequiredResourceProvidersAreRegistered(t *testing.T) {
@@ -14,12 +17,12 @@ func TestAccAzureRMEnsureRequiredResourceProvidersAreRegistered(t *testing.T) {
 	}
 
 	// this test intentionally checks all the RP's are registered - so this is intentional
-	armClient, err := getArmClient(config, true, "")
+	armClient, err := getArmClient(config, nil, true, "", azure.SenderOptions{}, nil)
 	if err != nil {
 		t.Fatalf("Error building ARM Client: %+v", err)
 	}
 
-	client := armClient.providersClient
+	client := armClient.resources().providersClient
 	ctx := testAccProvider.StopContext()
 	providerList, err := client.List(ctx, nil, "")
 	if err != nil {
@@ -48,3 +51,67 @@ func TestAccAzureRMEnsureRequiredResourceProvidersAreRegistered(t *testing.T) {
 		t.Fatalf("'%d' Resource Providers are still Pending Registration: %s", len(stillRequiringRegistration), spew.Sprint(stillRequiringRegistration))
 	}
 }
+
+func TestResourceProvidersForResources(t *testing.T) {
+	provider := Provider().(*schema.Provider)
+	requiredRPs := requiredResourceProviders()
+
+	for resourceName := range provider.ResourcesMap {
+		resourceProviders, known := resourceProvidersForResource(resourceName)
+		if !known {
+			t.Fatalf("No Resource Providers are defined for %q - please add it to `resourceProvidersForResources`", resourceName)
+		}
+
+		for _, rp := range resourceProviders {
+			if _, ok := requiredRPs[rp]; !ok {
+				t.Fatalf("Resource Provider %q (used by %q) isn't defined in `requiredResourceProviders`", rp, resourceName)
+			}
+		}
+	}
+}
+
+func TestResourceProvidersForResource(t *testing.T) {
+	testCases := []struct {
+		resourceName string
+		expected     []string
+		known        bool
+	}{
+		{
+			resourceName: "azurerm_unknown_resource",
+			known:        false,
+		},
+		{
+			resourceName: "azurerm_azuread_application",
+			expected:     []string{},
+			known:        true,
+		},
+		{
+			resourceName: "azurerm_virtual_network",
+			expected:     []string{"Microsoft.Network"},
+			known:        true,
+		},
+		{
+			resourceName: "azurerm_log_analytics_workspace",
+			expected:     []string{"Microsoft.OperationalInsights"},
+			known:        true,
+		},
+		{
+			resourceName: "azurerm_log_analytics_solution",
+			expected:     []string{"Microsoft.OperationalInsights", "Microsoft.OperationsManagement"},
+			known:        true,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.resourceName)
+
+		actual, known := resourceProvidersForResource(v.resourceName)
+		if known != v.known {
+			t.Fatalf("Expected known to be %t but got %t", v.known, known)
+		}
+
+		if !reflect.DeepEqual(actual, v.expected) && (len(actual) > 0 || len(v.expected) > 0) {
+			t.Fatalf("Expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
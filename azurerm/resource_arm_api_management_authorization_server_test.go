@@ -90,7 +90,7 @@ func TestAccAzureRMAPIManagementAuthorizationServer_complete(t *testing.T) {
 }
 
 func testCheckAzureRMAPIManagementAuthorizationServerDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).apiManagementAuthorizationServersClient
+	client := testAccProvider.Meta().(*ArmClient).apiManagement().apiManagementAuthorizationServersClient
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_api_management_authorization_server" {
 			continue
@@ -125,7 +125,7 @@ func testCheckAzureRMAPIManagementAuthorizationServerExists(resourceName string)
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 		serviceName := rs.Primary.Attributes["api_management_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).apiManagementAuthorizationServersClient
+		client := testAccProvider.Meta().(*ArmClient).apiManagement().apiManagementAuthorizationServersClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, serviceName, name)
 		if err != nil {
@@ -332,7 +332,7 @@ func testCheckAzureRMAutoScaleSettingExists(resourceName string) resource.TestCh
 			return fmt.Errorf("Bad: no resource group found in state for AutoScale Setting: %s", autoscaleSettingName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).autoscaleSettingsClient
+		conn := testAccProvider.Meta().(*ArmClient).monitor().autoscaleSettingsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, autoscaleSettingName)
@@ -349,7 +349,7 @@ func testCheckAzureRMAutoScaleSettingExists(resourceName string) resource.TestCh
 }
 
 func testCheckAzureRMAutoScaleSettingDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).autoscaleSettingsClient
+	conn := testAccProvider.Meta().(*ArmClient).monitor().autoscaleSettingsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -16,7 +16,9 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -30,6 +32,13 @@ func resourceArmBatchPool() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -123,7 +132,7 @@ func resourceArmBatchPool() *schema.Resource {
 						"id": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							ValidateFunc: ids.ValidateAnyOf(ids.ValidateImageID, ids.ValidateSharedImageID, ids.ValidateSharedImageVersionID),
 						},
 
 						"publisher": {
@@ -175,7 +184,7 @@ func resourceArmBatchPool() *schema.Resource {
 						"id": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							ValidateFunc: ids.ValidateBatchCertificateID,
 							// The ID returned for the certificate in the batch account and the certificate applied to the pool
 							// are not consistent in their casing which causes issues when referencing IDs across resources
 							// (as Terraform still sees differences to apply due to the casing)
@@ -320,8 +329,9 @@ func resourceArmBatchPool() *schema.Resource {
 }
 
 func resourceArmBatchPoolCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).batchPoolClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).batch().batchPoolClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure Batch pool creation.")
 
@@ -434,8 +444,9 @@ func resourceArmBatchPoolCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmBatchPoolUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).batchPoolClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	client := meta.(*ArmClient).batch().batchPoolClient
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -522,8 +533,9 @@ func resourceArmBatchPoolUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmBatchPoolRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).batchPoolClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	client := meta.(*ArmClient).batch().batchPoolClient
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -578,8 +590,9 @@ func resourceArmBatchPoolRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceArmBatchPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).batchPoolClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	client := meta.(*ArmClient).batch().batchPoolClient
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
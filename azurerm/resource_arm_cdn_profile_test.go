@@ -226,7 +226,7 @@ func testCheckAzureRMCdnProfileExists(resourceName string) resource.TestCheckFun
 			return fmt.Errorf("Bad: no resource group found in state for cdn profile: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).cdnProfilesClient
+		conn := testAccProvider.Meta().(*ArmClient).cdn().cdnProfilesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, name)
@@ -243,7 +243,7 @@ func testCheckAzureRMCdnProfileExists(resourceName string) resource.TestCheckFun
 }
 
 func testCheckAzureRMCdnProfileDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).cdnProfilesClient
+	conn := testAccProvider.Meta().(*ArmClient).cdn().cdnProfilesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
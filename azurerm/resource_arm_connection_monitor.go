@@ -2,10 +2,10 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
@@ -13,6 +13,8 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 )
 
 func resourceArmConnectionMonitor() *schema.Resource {
@@ -33,6 +35,13 @@ Information on migrating to the renamed resource can be found here: https://terr
 As such the existing 'azurerm_connection_monitor' resource is deprecated and will be removed in the next major version of the AzureRM Provider (2.0).
 `,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -75,7 +84,7 @@ As such the existing 'azurerm_connection_monitor' resource is deprecated and wil
 						"virtual_machine_id": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							ValidateFunc: ids.ValidateVirtualMachineID,
 						},
 						"port": {
 							Type:         schema.TypeInt,
@@ -96,7 +105,7 @@ As such the existing 'azurerm_connection_monitor' resource is deprecated and wil
 						"virtual_machine_id": {
 							Type:          schema.TypeString,
 							Optional:      true,
-							ValidateFunc:  azure.ValidateResourceID,
+							ValidateFunc:  ids.ValidateVirtualMachineID,
 							ConflictsWith: []string{"destination.0.address"},
 						},
 						"address": {
@@ -119,8 +128,9 @@ As such the existing 'azurerm_connection_monitor' resource is deprecated and wil
 }
 
 func resourceArmConnectionMonitorCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).connectionMonitorsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().connectionMonitorsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	watcherName := d.Get("network_watcher_name").(string)
@@ -188,8 +198,9 @@ func resourceArmConnectionMonitorCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceArmConnectionMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).connectionMonitorsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().connectionMonitorsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -236,8 +247,9 @@ func resourceArmConnectionMonitorRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmConnectionMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).connectionMonitorsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().connectionMonitorsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
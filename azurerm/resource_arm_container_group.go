@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
@@ -13,7 +14,9 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -27,6 +30,12 @@ func resourceArmContainerGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -47,9 +56,17 @@ func resourceArmContainerGroup() *schema.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(containerinstance.Public),
+					string(containerinstance.Private),
 				}, true),
 			},
 
+			"network_profile_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: ids.ValidateNetworkProfileID,
+			},
+
 			"os_type": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -416,8 +433,9 @@ func resourceArmContainerGroup() *schema.Resource {
 }
 
 func resourceArmContainerGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).containerGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).containerInstance().containerGroupsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resGroup := d.Get("resource_group_name").(string)
 	name := d.Get("name").(string)
@@ -468,6 +486,23 @@ func resourceArmContainerGroupCreate(d *schema.ResourceData, meta interface{}) e
 		containerGroup.ContainerGroupProperties.IPAddress.DNSNameLabel = &dnsNameLabel
 	}
 
+	networkProfileId := d.Get("network_profile_id").(string)
+	if strings.EqualFold(IPAddressType, string(containerinstance.Private)) {
+		if networkProfileId == "" {
+			return fmt.Errorf("`network_profile_id` must be specified when `ip_address_type` is set to `Private`")
+		}
+
+		if containerGroup.ContainerGroupProperties.IPAddress.DNSNameLabel != nil {
+			return fmt.Errorf("`dns_name_label` cannot be specified when `ip_address_type` is set to `Private`")
+		}
+
+		containerGroup.ContainerGroupProperties.NetworkProfile = &containerinstance.ContainerGroupNetworkProfile{
+			ID: utils.String(networkProfileId),
+		}
+	} else if networkProfileId != "" {
+		return fmt.Errorf("`network_profile_id` can only be specified when `ip_address_type` is set to `Private`")
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, containerGroup)
 	if err != nil {
 		return fmt.Errorf("Error creating/updating container group %q (Resource Group %q): %+v", name, resGroup, err)
@@ -492,8 +527,9 @@ func resourceArmContainerGroupCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmContainerGroupRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).containerGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	client := meta.(*ArmClient).containerInstance().containerGroupsClient
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -541,6 +577,12 @@ func resourceArmContainerGroupRead(d *schema.ResourceData, meta interface{}) err
 			d.Set("fqdn", address.Fqdn)
 		}
 
+		networkProfileId := ""
+		if profile := props.NetworkProfile; profile != nil && profile.ID != nil {
+			networkProfileId = *profile.ID
+		}
+		d.Set("network_profile_id", networkProfileId)
+
 		d.Set("restart_policy", string(props.RestartPolicy))
 		d.Set("os_type", string(props.OsType))
 
@@ -555,8 +597,9 @@ func resourceArmContainerGroupRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmContainerGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).containerGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	client := meta.(*ArmClient).containerInstance().containerGroupsClient
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
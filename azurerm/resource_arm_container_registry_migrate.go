@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -81,7 +82,13 @@ func updateV1ToV2StorageAccountName(is *terraform.InstanceState, meta interface{
 
 	account := inputAccount.(map[string]interface{})
 	name := account["name"].(string)
-	storageAccountId, err := findAzureStorageAccountIdFromName(name, meta)
+
+	// State Migrations aren't run as part of an operation with a configurable timeout, so the
+	// lookup is bound by the default Read timeout of the Container Registry instead
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, *resourceArmContainerRegistry().Timeouts.Read)
+	defer cancel()
+
+	storageAccountId, err := findAzureStorageAccountIdFromName(ctx, name, meta)
 	if err != nil {
 		return err
 	}
@@ -90,9 +97,8 @@ func updateV1ToV2StorageAccountName(is *terraform.InstanceState, meta interface{
 	return nil
 }
 
-func findAzureStorageAccountIdFromName(name string, meta interface{}) (string, error) {
-	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).storageServiceClient
+func findAzureStorageAccountIdFromName(ctx context.Context, name string, meta interface{}) (string, error) {
+	client := meta.(*ArmClient).storage().storageServiceClient
 	accounts, err := client.List(ctx)
 	if err != nil {
 		return "", err
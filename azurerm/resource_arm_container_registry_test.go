@@ -335,7 +335,7 @@ func TestAccAzureRMContainerRegistry_geoReplication(t *testing.T) {
 }
 
 func testCheckAzureRMContainerRegistryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).containerRegistryClient
+	conn := testAccProvider.Meta().(*ArmClient).containerRegistry().containerRegistryClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -373,7 +373,7 @@ func testCheckAzureRMContainerRegistryExists(resourceName string) resource.TestC
 			return fmt.Errorf("Bad: no resource group found in state for Container Registry: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).containerRegistryClient
+		conn := testAccProvider.Meta().(*ArmClient).containerRegistry().containerRegistryClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, name)
@@ -403,7 +403,7 @@ func testCheckAzureRMContainerRegistryGeoreplications(resourceName string, sku s
 			return fmt.Errorf("Bad: no resource group found in state for Container Registry: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).containerRegistryReplicationsClient
+		conn := testAccProvider.Meta().(*ArmClient).containerRegistry().containerRegistryReplicationsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.List(ctx, resourceGroup, name)
@@ -16,8 +16,9 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -32,6 +33,13 @@ func resourceArmCosmosDBAccount() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -225,7 +233,7 @@ func resourceArmCosmosDBAccount() *schema.Resource {
 						"id": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							ValidateFunc: ids.ValidateSubnetID,
 						},
 					},
 				},
@@ -297,8 +305,9 @@ func resourceArmCosmosDBAccount() *schema.Resource {
 }
 
 func resourceArmCosmosDBAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).cosmosDBClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).cosmosDB().cosmosDBClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Cosmos DB Account creation.")
 
 	name := d.Get("name").(string)
@@ -399,8 +408,9 @@ func resourceArmCosmosDBAccountCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmCosmosDBAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).cosmosDBClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).cosmosDB().cosmosDBClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Cosmos DB Account update.")
 
 	//move to function
@@ -547,8 +557,9 @@ func resourceArmCosmosDBAccountUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmCosmosDBAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).cosmosDBClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).cosmosDB().cosmosDBClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -683,8 +694,9 @@ func resourceArmCosmosDBAccountRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceArmCosmosDBAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).cosmosDBClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).cosmosDB().cosmosDBClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -94,7 +94,7 @@ func testCheckAzureRMDataFactoryDatasetMySQLExists(name string) resource.TestChe
 			return fmt.Errorf("Bad: no resource group found in state for Data Factory: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).dataFactoryDatasetClient
+		client := testAccProvider.Meta().(*ArmClient).dataFactory().dataFactoryDatasetClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
@@ -111,7 +111,7 @@ func testCheckAzureRMDataFactoryDatasetMySQLExists(name string) resource.TestChe
 }
 
 func testCheckAzureRMDataFactoryDatasetMySQLDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).dataFactoryDatasetClient
+	client := testAccProvider.Meta().(*ArmClient).dataFactory().dataFactoryDatasetClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
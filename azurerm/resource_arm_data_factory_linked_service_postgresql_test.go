@@ -100,7 +100,7 @@ func testCheckAzureRMDataFactoryLinkedServicePostgreSQLExists(name string) resou
 			return fmt.Errorf("Bad: no resource group found in state for Data Factory: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).dataFactoryLinkedServiceClient
+		client := testAccProvider.Meta().(*ArmClient).dataFactory().dataFactoryLinkedServiceClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
@@ -117,7 +117,7 @@ func testCheckAzureRMDataFactoryLinkedServicePostgreSQLExists(name string) resou
 }
 
 func testCheckAzureRMDataFactoryLinkedServicePostgreSQLDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).dataFactoryLinkedServiceClient
+	client := testAccProvider.Meta().(*ArmClient).dataFactory().dataFactoryLinkedServiceClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
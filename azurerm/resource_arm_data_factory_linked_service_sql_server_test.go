@@ -69,7 +69,7 @@ func testCheckAzureRMDataFactoryLinkedServiceSQLServerExists(name string) resour
 			return fmt.Errorf("Bad: no resource group found in state for Data Factory: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).dataFactoryLinkedServiceClient
+		client := testAccProvider.Meta().(*ArmClient).dataFactory().dataFactoryLinkedServiceClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
@@ -86,7 +86,7 @@ func testCheckAzureRMDataFactoryLinkedServiceSQLServerExists(name string) resour
 }
 
 func testCheckAzureRMDataFactoryLinkedServiceSQLServerDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).dataFactoryLinkedServiceClient
+	client := testAccProvider.Meta().(*ArmClient).dataFactory().dataFactoryLinkedServiceClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
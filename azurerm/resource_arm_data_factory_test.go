@@ -208,7 +208,7 @@ func testCheckAzureRMDataFactoryExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for Data Factory: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).dataFactoryClient
+		client := testAccProvider.Meta().(*ArmClient).dataFactory().dataFactoryClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name, "")
@@ -238,7 +238,7 @@ func testCheckAzureRMDataFactoryDisappears(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for Data Factory: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).dataFactoryClient
+		client := testAccProvider.Meta().(*ArmClient).dataFactory().dataFactoryClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Delete(ctx, resourceGroup, name)
@@ -253,7 +253,7 @@ func testCheckAzureRMDataFactoryDisappears(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMDataFactoryDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).dataFactoryClient
+	client := testAccProvider.Meta().(*ArmClient).dataFactory().dataFactoryClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
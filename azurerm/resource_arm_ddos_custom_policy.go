@@ -0,0 +1,293 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDDoSCustomPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDDoSCustomPolicyCreateUpdate,
+		Read:   resourceArmDDoSCustomPolicyRead,
+		Update: resourceArmDDoSCustomPolicyCreateUpdate,
+		Delete: resourceArmDDoSCustomPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"protocol_custom_setting": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 3,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.DdosCustomPolicyProtocolSyn),
+								string(network.DdosCustomPolicyProtocolTCP),
+								string(network.DdosCustomPolicyProtocolUDP),
+							}, false),
+						},
+
+						"trigger_rate_override": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"source_rate_override": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"trigger_sensitivity_override": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(network.Default),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.Default),
+								string(network.High),
+								string(network.Low),
+								string(network.Relaxed),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"public_ip_address_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDDoSCustomPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().ddosCustomPoliciesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for DDoS Custom Policy creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing DDoS Custom Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_ddos_custom_policy", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := network.DdosCustomPolicy{
+		Location: utils.String(location),
+		DdosCustomPolicyPropertiesFormat: &network.DdosCustomPolicyPropertiesFormat{
+			ProtocolCustomSettings: expandArmDDoSCustomPolicyProtocolCustomSettings(d.Get("protocol_custom_setting").([]interface{})),
+		},
+		Tags: expandTags(tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating DDoS Custom Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of DDoS Custom Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving DDoS Custom Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read DDoS Custom Policy %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDDoSCustomPolicyRead(d, meta)
+}
+
+func resourceArmDDoSCustomPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().ddosCustomPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseNetworkDDoSCustomPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] DDoS Custom Policy %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving DDoS Custom Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.DdosCustomPolicyPropertiesFormat; props != nil {
+		if err := d.Set("protocol_custom_setting", flattenArmDDoSCustomPolicyProtocolCustomSettings(props.ProtocolCustomSettings)); err != nil {
+			return fmt.Errorf("Error setting `protocol_custom_setting`: %+v", err)
+		}
+
+		publicIPAddressIds := make([]interface{}, 0)
+		if addresses := props.PublicIPAddresses; addresses != nil {
+			for _, address := range *addresses {
+				if address.ID != nil {
+					publicIPAddressIds = append(publicIPAddressIds, *address.ID)
+				}
+			}
+		}
+		if err := d.Set("public_ip_address_ids", publicIPAddressIds); err != nil {
+			return fmt.Errorf("Error setting `public_ip_address_ids`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmDDoSCustomPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().ddosCustomPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseNetworkDDoSCustomPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting DDoS Custom Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of DDoS Custom Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmDDoSCustomPolicyProtocolCustomSettings(input []interface{}) *[]network.ProtocolCustomSettingsFormat {
+	settings := make([]network.ProtocolCustomSettingsFormat, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		setting := network.ProtocolCustomSettingsFormat{
+			Protocol:                   network.DdosCustomPolicyProtocol(v["protocol"].(string)),
+			TriggerSensitivityOverride: network.DdosCustomPolicyTriggerSensitivityOverride(v["trigger_sensitivity_override"].(string)),
+		}
+
+		if triggerRate := v["trigger_rate_override"].(string); triggerRate != "" {
+			setting.TriggerRateOverride = utils.String(triggerRate)
+		}
+		if sourceRate := v["source_rate_override"].(string); sourceRate != "" {
+			setting.SourceRateOverride = utils.String(sourceRate)
+		}
+
+		settings = append(settings, setting)
+	}
+
+	return &settings
+}
+
+func flattenArmDDoSCustomPolicyProtocolCustomSettings(input *[]network.ProtocolCustomSettingsFormat) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, setting := range *input {
+		triggerRate := ""
+		if setting.TriggerRateOverride != nil {
+			triggerRate = *setting.TriggerRateOverride
+		}
+
+		sourceRate := ""
+		if setting.SourceRateOverride != nil {
+			sourceRate = *setting.SourceRateOverride
+		}
+
+		results = append(results, map[string]interface{}{
+			"protocol":                     string(setting.Protocol),
+			"trigger_rate_override":        triggerRate,
+			"source_rate_override":         sourceRate,
+			"trigger_sensitivity_override": string(setting.TriggerSensitivityOverride),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,215 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMDDoSCustomPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_ddos_custom_policy.test"
+	ri := testAccRandTimeInt(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDDoSCustomPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDDoSCustomPolicy_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDDoSCustomPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "protocol_custom_setting.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDDoSCustomPolicy_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_ddos_custom_policy.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDDoSCustomPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDDoSCustomPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDDoSCustomPolicyExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMDDoSCustomPolicy_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_ddos_custom_policy"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDDoSCustomPolicy_complete(t *testing.T) {
+	resourceName := "azurerm_ddos_custom_policy.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDDoSCustomPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDDoSCustomPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDDoSCustomPolicyExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMDDoSCustomPolicy_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDDoSCustomPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "protocol_custom_setting.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "protocol_custom_setting.0.protocol", "Tcp"),
+					resource.TestCheckResourceAttr(resourceName, "protocol_custom_setting.0.trigger_sensitivity_override", "High"),
+					resource.TestCheckResourceAttr(resourceName, "protocol_custom_setting.1.protocol", "Udp"),
+					resource.TestCheckResourceAttr(resourceName, "protocol_custom_setting.1.trigger_sensitivity_override", "Low"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDDoSCustomPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		id, err := ids.ParseNetworkDDoSCustomPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).network().ddosCustomPoliciesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: DDoS Custom Policy %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on ddosCustomPoliciesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDDoSCustomPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network().ddosCustomPoliciesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_ddos_custom_policy" {
+			continue
+		}
+
+		id, err := ids.ParseNetworkDDoSCustomPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("DDoS Custom Policy %q (Resource Group %q) still exists", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDDoSCustomPolicy_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_ddos_custom_policy" "test" {
+  name                = "acctestddospolicy-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMDDoSCustomPolicy_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ddos_custom_policy" "import" {
+  name                = "${azurerm_ddos_custom_policy.test.name}"
+  location            = "${azurerm_ddos_custom_policy.test.location}"
+  resource_group_name = "${azurerm_ddos_custom_policy.test.resource_group_name}"
+}
+`, testAccAzureRMDDoSCustomPolicy_basic(rInt, location))
+}
+
+func testAccAzureRMDDoSCustomPolicy_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_ddos_custom_policy" "test" {
+  name                = "acctestddospolicy-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  protocol_custom_setting {
+    protocol                     = "Tcp"
+    trigger_sensitivity_override = "High"
+  }
+
+  protocol_custom_setting {
+    protocol                     = "Udp"
+    trigger_sensitivity_override = "Low"
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, rInt, location, rInt)
+}
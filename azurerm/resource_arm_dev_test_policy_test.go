@@ -106,7 +106,7 @@ func testCheckAzureRMDevTestPolicyExists(resourceName string) resource.TestCheck
 		labName := rs.Primary.Attributes["lab_name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		conn := testAccProvider.Meta().(*ArmClient).devTestPoliciesClient
+		conn := testAccProvider.Meta().(*ArmClient).devTest().devTestPoliciesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, labName, policySetName, policyName, "")
@@ -123,7 +123,7 @@ func testCheckAzureRMDevTestPolicyExists(resourceName string) resource.TestCheck
 }
 
 func testCheckAzureRMDevTestPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).devTestPoliciesClient
+	conn := testAccProvider.Meta().(*ArmClient).devTest().devTestPoliciesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -3,11 +3,13 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -23,6 +25,13 @@ func resourceArmExpressRouteCircuit() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -105,8 +114,9 @@ func resourceArmExpressRouteCircuit() *schema.Resource {
 }
 
 func resourceArmExpressRouteCircuitCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).expressRouteCircuitClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().expressRouteCircuitClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM ExpressRoute Circuit creation.")
 
@@ -176,7 +186,10 @@ func resourceArmExpressRouteCircuitCreateUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceArmExpressRouteCircuitRead(d *schema.ResourceData, meta interface{}) error {
-	resp, resourceGroup, err := retrieveErcByResourceId(d.Id(), meta)
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	resp, resourceGroup, err := retrieveErcByResourceId(ctx, d.Id(), meta)
 	if err != nil {
 		return err
 	}
@@ -216,8 +229,9 @@ func resourceArmExpressRouteCircuitRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceArmExpressRouteCircuitDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).expressRouteCircuitClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().expressRouteCircuitClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resourceGroup, name, err := extractResourceGroupAndErcName(d.Id())
 	if err != nil {
@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -24,6 +27,13 @@ func resourceArmExpressRouteCircuitPeering() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"peering_type": {
 				Type:     schema.TypeString,
@@ -86,6 +96,12 @@ func resourceArmExpressRouteCircuitPeering() *schema.Resource {
 				},
 			},
 
+			"route_filter_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: ids.ValidateRouteFilterID,
+			},
+
 			"azure_asn": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -105,8 +121,9 @@ func resourceArmExpressRouteCircuitPeering() *schema.Resource {
 }
 
 func resourceArmExpressRouteCircuitPeeringCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).expressRoutePeeringsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().expressRoutePeeringsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Express Route Peering create/update.")
 
@@ -156,6 +173,12 @@ func resourceArmExpressRouteCircuitPeeringCreateUpdate(d *schema.ResourceData, m
 		parameters.ExpressRouteCircuitPeeringPropertiesFormat.MicrosoftPeeringConfig = peeringConfig
 	}
 
+	if v := d.Get("route_filter_id").(string); v != "" {
+		parameters.ExpressRouteCircuitPeeringPropertiesFormat.RouteFilter = &network.RouteFilter{
+			ID: utils.String(v),
+		}
+	}
+
 	azureRMLockByName(circuitName, expressRouteCircuitResourceName)
 	defer azureRMUnlockByName(circuitName, expressRouteCircuitResourceName)
 
@@ -179,8 +202,9 @@ func resourceArmExpressRouteCircuitPeeringCreateUpdate(d *schema.ResourceData, m
 }
 
 func resourceArmExpressRouteCircuitPeeringRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).expressRoutePeeringsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().expressRoutePeeringsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -216,14 +240,21 @@ func resourceArmExpressRouteCircuitPeeringRead(d *schema.ResourceData, meta inte
 		if err := d.Set("microsoft_peering_config", config); err != nil {
 			return fmt.Errorf("Error setting `microsoft_peering_config`: %+v", err)
 		}
+
+		routeFilterId := ""
+		if props.RouteFilter != nil && props.RouteFilter.ID != nil {
+			routeFilterId = *props.RouteFilter.ID
+		}
+		d.Set("route_filter_id", routeFilterId)
 	}
 
 	return nil
 }
 
 func resourceArmExpressRouteCircuitPeeringDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).expressRoutePeeringsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().expressRoutePeeringsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -96,6 +96,32 @@ func testAccAzureRMExpressRouteCircuitPeering_microsoftPeering(t *testing.T) {
 	})
 }
 
+func testAccAzureRMExpressRouteCircuitPeering_microsoftPeeringRouteFilter(t *testing.T) {
+	resourceName := "azurerm_express_route_circuit_peering.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMExpressRouteCircuitPeeringDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMExpressRouteCircuitPeering_msPeeringRouteFilter(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteCircuitPeeringExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "route_filter_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckAzureRMExpressRouteCircuitPeeringExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -110,7 +136,7 @@ func testCheckAzureRMExpressRouteCircuitPeeringExists(resourceName string) resou
 			return fmt.Errorf("Bad: no resource group found in state for Express Route Circuit Peering: %s", peeringType)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).expressRoutePeeringsClient
+		client := testAccProvider.Meta().(*ArmClient).network().expressRoutePeeringsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, circuitName, peeringType)
@@ -127,7 +153,7 @@ func testCheckAzureRMExpressRouteCircuitPeeringExists(resourceName string) resou
 }
 
 func testCheckAzureRMExpressRouteCircuitPeeringDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).expressRoutePeeringsClient
+	client := testAccProvider.Meta().(*ArmClient).network().expressRoutePeeringsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -251,3 +277,53 @@ resource "azurerm_express_route_circuit_peering" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMExpressRouteCircuitPeering_msPeeringRouteFilter(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_express_route_circuit" "test" {
+  name                  = "acctest-erc-%d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  service_provider_name = "Equinix"
+  peering_location      = "Silicon Valley"
+  bandwidth_in_mbps     = 50
+
+  sku {
+    tier   = "Premium"
+    family = "MeteredData"
+  }
+}
+
+resource "azurerm_route_filter" "test" {
+  name                = "acctestrf-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_route_filter_rule" "test" {
+  name            = "acctestrfr-%d"
+  route_filter_id = "${azurerm_route_filter.test.id}"
+  communities     = ["12076:5010"]
+}
+
+resource "azurerm_express_route_circuit_peering" "test" {
+  peering_type                  = "MicrosoftPeering"
+  express_route_circuit_name    = "${azurerm_express_route_circuit.test.name}"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  peer_asn                      = 100
+  primary_peer_address_prefix   = "192.168.1.0/30"
+  secondary_peer_address_prefix = "192.168.2.0/30"
+  vlan_id                       = 300
+  route_filter_id               = "${azurerm_route_filter_rule.test.route_filter_id}"
+
+  microsoft_peering_config {
+    advertised_public_prefixes = ["123.1.0.0/24"]
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
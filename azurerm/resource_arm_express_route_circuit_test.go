@@ -33,6 +33,12 @@ func TestAccAzureRMExpressRouteCircuit(t *testing.T) {
 		},
 		"MicrosoftPeering": {
 			"microsoftPeering": testAccAzureRMExpressRouteCircuitPeering_microsoftPeering,
+			"routeFilter":      testAccAzureRMExpressRouteCircuitPeering_microsoftPeeringRouteFilter,
+		},
+		"connection": {
+			"basic":          testAccAzureRMExpressRouteConnection_basic,
+			"requiresImport": testAccAzureRMExpressRouteConnection_requiresImport,
+			"update":         testAccAzureRMExpressRouteConnection_update,
 		},
 		"authorization": {
 			"basic":          testAccAzureRMExpressRouteCircuitAuthorization_basic,
@@ -286,7 +292,7 @@ func testCheckAzureRMExpressRouteCircuitExists(resourceName string, erc *network
 			return fmt.Errorf("Bad: no resource group found in state for Express Route Circuit: %s", expressRouteCircuitName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).expressRouteCircuitClient
+		client := testAccProvider.Meta().(*ArmClient).network().expressRouteCircuitClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, expressRouteCircuitName)
@@ -305,7 +311,7 @@ func testCheckAzureRMExpressRouteCircuitExists(resourceName string, erc *network
 }
 
 func testCheckAzureRMExpressRouteCircuitDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).expressRouteCircuitClient
+	client := testAccProvider.Meta().(*ArmClient).network().expressRouteCircuitClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
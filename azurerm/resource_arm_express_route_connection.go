@@ -0,0 +1,225 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmExpressRouteConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmExpressRouteConnectionCreateUpdate,
+		Read:   resourceArmExpressRouteConnectionRead,
+		Update: resourceArmExpressRouteConnectionCreateUpdate,
+		Delete: resourceArmExpressRouteConnectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"express_route_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ids.ValidateExpressRouteGatewayID,
+			},
+
+			"express_route_circuit_peering_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ids.ValidateExpressRouteCircuitPeeringID,
+			},
+
+			"authorization_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"routing_weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 32000),
+			},
+		},
+	}
+}
+
+func resourceArmExpressRouteConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().expressRouteConnectionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for ExpressRoute Connection creation.")
+
+	name := d.Get("name").(string)
+	gatewayId, err := ids.ParseExpressRouteGatewayID(d.Get("express_route_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := gatewayId.ResourceGroup
+	gatewayName := gatewayId.Name
+
+	// Connections to the same ExpressRoute Gateway can't be provisioned concurrently
+	azureRMLockByName(gatewayName, expressRouteGatewayResourceName)
+	defer azureRMUnlockByName(gatewayName, expressRouteGatewayResourceName)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, gatewayName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Connection %q (ExpressRoute Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_express_route_connection", *existing.ID)
+		}
+	}
+
+	connection := network.ExpressRouteConnection{
+		Name: utils.String(name),
+		ExpressRouteConnectionProperties: &network.ExpressRouteConnectionProperties{
+			ExpressRouteCircuitPeering: &network.ExpressRouteCircuitPeeringID{
+				ID: utils.String(d.Get("express_route_circuit_peering_id").(string)),
+			},
+			RoutingWeight: utils.Int32(int32(d.Get("routing_weight").(int))),
+		},
+	}
+
+	if v := d.Get("authorization_key").(string); v != "" {
+		connection.ExpressRouteConnectionProperties.AuthorizationKey = utils.String(v)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, gatewayName, name, connection)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Connection %q (ExpressRoute Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Connection %q (ExpressRoute Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, gatewayName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Connection %q (ExpressRoute Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Connection %q (ExpressRoute Gateway %q / Resource Group %q) ID", name, gatewayName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmExpressRouteConnectionRead(d, meta)
+}
+
+func resourceArmExpressRouteConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().expressRouteConnectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseExpressRouteConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	gatewayName := id.ExpressRouteGatewayName
+	name := id.Name
+
+	resp, err := client.Get(ctx, resourceGroup, gatewayName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Connection %q (ExpressRoute Gateway %q / Resource Group %q) was not found - removing from state!", name, gatewayName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Connection %q (ExpressRoute Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("express_route_gateway_id", ids.NewExpressRouteGatewayID(id.SubscriptionID, resourceGroup, gatewayName).String())
+
+	if props := resp.ExpressRouteConnectionProperties; props != nil {
+		circuitPeeringId := ""
+		if props.ExpressRouteCircuitPeering != nil && props.ExpressRouteCircuitPeering.ID != nil {
+			circuitPeeringId = *props.ExpressRouteCircuitPeering.ID
+		}
+		d.Set("express_route_circuit_peering_id", circuitPeeringId)
+
+		routingWeight := 0
+		if props.RoutingWeight != nil {
+			routingWeight = int(*props.RoutingWeight)
+		}
+		d.Set("routing_weight", routingWeight)
+
+		// the Authorization Key is only returned when it's been specified
+		if props.AuthorizationKey != nil {
+			d.Set("authorization_key", props.AuthorizationKey)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmExpressRouteConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().expressRouteConnectionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseExpressRouteConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	gatewayName := id.ExpressRouteGatewayName
+	name := id.Name
+
+	azureRMLockByName(gatewayName, expressRouteGatewayResourceName)
+	defer azureRMUnlockByName(gatewayName, expressRouteGatewayResourceName)
+
+	future, err := client.Delete(ctx, resourceGroup, gatewayName, name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Connection %q (ExpressRoute Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of Connection %q (ExpressRoute Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,227 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func testAccAzureRMExpressRouteConnection_basic(t *testing.T) {
+	resourceName := "azurerm_express_route_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMExpressRouteConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMExpressRouteConnection_basicConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "routing_weight", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMExpressRouteConnection_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_express_route_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMExpressRouteConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMExpressRouteConnection_basicConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteConnectionExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMExpressRouteConnection_requiresImportConfig(ri, location),
+				ExpectError: testRequiresImportError("azurerm_express_route_connection"),
+			},
+		},
+	})
+}
+
+func testAccAzureRMExpressRouteConnection_update(t *testing.T) {
+	resourceName := "azurerm_express_route_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMExpressRouteConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMExpressRouteConnection_basicConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteConnectionExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMExpressRouteConnection_routingWeightConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "routing_weight", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMExpressRouteConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		id, err := ids.ParseExpressRouteConnectionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).network().expressRouteConnectionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.ExpressRouteGatewayName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Connection %q (ExpressRoute Gateway %q / Resource Group %q) does not exist", id.Name, id.ExpressRouteGatewayName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on expressRouteConnectionsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMExpressRouteConnectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network().expressRouteConnectionsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_express_route_connection" {
+			continue
+		}
+
+		id, err := ids.ParseExpressRouteConnectionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.ExpressRouteGatewayName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Connection %q (ExpressRoute Gateway %q / Resource Group %q) still exists", id.Name, id.ExpressRouteGatewayName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMExpressRouteConnection_template(rInt int, location string) string {
+	template := testAccAzureRMExpressRouteGateway_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_circuit" "test" {
+  name                  = "acctest-erc-%d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  service_provider_name = "Equinix"
+  peering_location      = "Silicon Valley"
+  bandwidth_in_mbps     = 50
+
+  sku {
+    tier   = "Standard"
+    family = "MeteredData"
+  }
+}
+
+resource "azurerm_express_route_circuit_peering" "test" {
+  peering_type                  = "AzurePrivatePeering"
+  express_route_circuit_name    = "${azurerm_express_route_circuit.test.name}"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  shared_key                    = "SSSSsssssshhhhhItsASecret"
+  peer_asn                      = 100
+  primary_peer_address_prefix   = "192.168.1.0/30"
+  secondary_peer_address_prefix = "192.168.2.0/30"
+  vlan_id                       = 100
+}
+`, template, rInt)
+}
+
+func testAccAzureRMExpressRouteConnection_basicConfig(rInt int, location string) string {
+	template := testAccAzureRMExpressRouteConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_connection" "test" {
+  name                             = "acctesterc%d"
+  express_route_gateway_id         = "${azurerm_express_route_gateway.test.id}"
+  express_route_circuit_peering_id = "${azurerm_express_route_circuit_peering.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMExpressRouteConnection_requiresImportConfig(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_connection" "import" {
+  name                             = "${azurerm_express_route_connection.test.name}"
+  express_route_gateway_id         = "${azurerm_express_route_connection.test.express_route_gateway_id}"
+  express_route_circuit_peering_id = "${azurerm_express_route_connection.test.express_route_circuit_peering_id}"
+}
+`, testAccAzureRMExpressRouteConnection_basicConfig(rInt, location))
+}
+
+func testAccAzureRMExpressRouteConnection_routingWeightConfig(rInt int, location string) string {
+	template := testAccAzureRMExpressRouteConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_connection" "test" {
+  name                             = "acctesterc%d"
+  express_route_gateway_id         = "${azurerm_express_route_gateway.test.id}"
+  express_route_circuit_peering_id = "${azurerm_express_route_circuit_peering.test.id}"
+  routing_weight                   = 10
+}
+`, template, rInt)
+}
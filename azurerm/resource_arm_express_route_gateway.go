@@ -0,0 +1,219 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var expressRouteGatewayResourceName = "azurerm_express_route_gateway"
+
+func resourceArmExpressRouteGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmExpressRouteGatewayCreateUpdate,
+		Read:   resourceArmExpressRouteGatewayRead,
+		Update: resourceArmExpressRouteGatewayCreateUpdate,
+		Delete: resourceArmExpressRouteGatewayDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"virtual_hub_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ids.ValidateVirtualHubID,
+			},
+
+			"scale_units": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmExpressRouteGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().expressRouteGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for ExpressRoute Gateway creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	azureRMLockByName(name, expressRouteGatewayResourceName)
+	defer azureRMUnlockByName(name, expressRouteGatewayResourceName)
+
+	existing, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing ExpressRoute Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_express_route_gateway", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	gateway := network.ExpressRouteGateway{
+		Location: utils.String(location),
+		ExpressRouteGatewayProperties: &network.ExpressRouteGatewayProperties{
+			VirtualHub: &network.VirtualHubID{
+				ID: utils.String(d.Get("virtual_hub_id").(string)),
+			},
+			AutoScaleConfiguration: &network.ExpressRouteGatewayPropertiesAutoScaleConfiguration{
+				Bounds: &network.ExpressRouteGatewayPropertiesAutoScaleConfigurationBounds{
+					Min: utils.Int32(int32(d.Get("scale_units").(int))),
+				},
+			},
+		},
+		Tags: expandTags(tags),
+	}
+
+	// the ExpressRoute Connections are managed using the `azurerm_express_route_connection` resource
+	if props := existing.ExpressRouteGatewayProperties; props != nil {
+		gateway.ExpressRouteGatewayProperties.ExpressRouteConnections = props.ExpressRouteConnections
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, gateway)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating ExpressRoute Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of ExpressRoute Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving ExpressRoute Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ExpressRoute Gateway %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmExpressRouteGatewayRead(d, meta)
+}
+
+func resourceArmExpressRouteGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().expressRouteGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseExpressRouteGatewayID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] ExpressRoute Gateway %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving ExpressRoute Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.ExpressRouteGatewayProperties; props != nil {
+		virtualHubId := ""
+		if props.VirtualHub != nil && props.VirtualHub.ID != nil {
+			virtualHubId = *props.VirtualHub.ID
+		}
+		d.Set("virtual_hub_id", virtualHubId)
+
+		scaleUnits := 0
+		if config := props.AutoScaleConfiguration; config != nil && config.Bounds != nil && config.Bounds.Min != nil {
+			scaleUnits = int(*config.Bounds.Min)
+		}
+		d.Set("scale_units", scaleUnits)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmExpressRouteGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().expressRouteGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseExpressRouteGatewayID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	azureRMLockByName(name, expressRouteGatewayResourceName)
+	defer azureRMUnlockByName(name, expressRouteGatewayResourceName)
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting ExpressRoute Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of ExpressRoute Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMExpressRouteGateway_basic(t *testing.T) {
+	resourceName := "azurerm_express_route_gateway.test"
+	ri := testAccRandTimeInt(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMExpressRouteGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMExpressRouteGateway_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale_units", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMExpressRouteGateway_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_express_route_gateway.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMExpressRouteGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMExpressRouteGateway_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteGatewayExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMExpressRouteGateway_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_express_route_gateway"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMExpressRouteGateway_update(t *testing.T) {
+	resourceName := "azurerm_express_route_gateway.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMExpressRouteGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMExpressRouteGateway_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteGatewayExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMExpressRouteGateway_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale_units", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMExpressRouteGatewayExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).network().expressRouteGatewaysClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: ExpressRoute Gateway %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on expressRouteGatewaysClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMExpressRouteGatewayDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network().expressRouteGatewaysClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_express_route_gateway" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("ExpressRoute Gateway %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMExpressRouteGateway_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualHub_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_gateway" "test" {
+  name                = "acctesterg%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  virtual_hub_id      = "${azurerm_virtual_hub.test.id}"
+  scale_units         = 1
+}
+`, template, rInt)
+}
+
+func testAccAzureRMExpressRouteGateway_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_gateway" "import" {
+  name                = "${azurerm_express_route_gateway.test.name}"
+  resource_group_name = "${azurerm_express_route_gateway.test.resource_group_name}"
+  location            = "${azurerm_express_route_gateway.test.location}"
+  virtual_hub_id      = "${azurerm_express_route_gateway.test.virtual_hub_id}"
+  scale_units         = "${azurerm_express_route_gateway.test.scale_units}"
+}
+`, testAccAzureRMExpressRouteGateway_basic(rInt, location))
+}
+
+func testAccAzureRMExpressRouteGateway_complete(rInt int, location string) string {
+	template := testAccAzureRMVirtualHub_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_gateway" "test" {
+  name                = "acctesterg%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  virtual_hub_id      = "${azurerm_virtual_hub.test.id}"
+  scale_units         = 2
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,397 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmExpressRoutePort() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmExpressRoutePortCreateUpdate,
+		Read:   resourceArmExpressRoutePortRead,
+		Update: resourceArmExpressRoutePortCreateUpdate,
+		Delete: resourceArmExpressRoutePortDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"peering_location": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"bandwidth_in_gbps": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"encapsulation": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Dot1Q),
+					string(network.QinQ),
+				}, false),
+			},
+
+			"link1": expressRoutePortLinkSchema(),
+
+			"link2": expressRoutePortLinkSchema(),
+
+			"ethertype": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"mtu": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"guid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func expressRoutePortLinkSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"admin_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"router_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"interface_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"patch_panel_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"rack_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"connector_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmExpressRoutePortCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().expressRoutePortsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for ExpressRoute Port creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	existing, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing ExpressRoute Port %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_express_route_port", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	port := network.ExpressRoutePort{
+		Location: utils.String(location),
+		ExpressRoutePortPropertiesFormat: &network.ExpressRoutePortPropertiesFormat{
+			PeeringLocation: utils.String(d.Get("peering_location").(string)),
+			BandwidthInGbps: utils.Int32(int32(d.Get("bandwidth_in_gbps").(int))),
+			Encapsulation:   network.ExpressRoutePortsEncapsulation(d.Get("encapsulation").(string)),
+		},
+		Tags: expandTags(tags),
+	}
+
+	// the Links are allocated by Azure when the Port is created, after which only their Admin State can be changed
+	if props := existing.ExpressRoutePortPropertiesFormat; props != nil && props.Links != nil {
+		links := *props.Links
+		for i, key := range []string{"link1", "link2"} {
+			if i >= len(links) || links[i].ExpressRouteLinkPropertiesFormat == nil {
+				continue
+			}
+
+			links[i].ExpressRouteLinkPropertiesFormat.AdminState = expandArmExpressRoutePortLinkAdminState(d.Get(key).([]interface{}))
+		}
+		port.ExpressRoutePortPropertiesFormat.Links = &links
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, port)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating ExpressRoute Port %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of ExpressRoute Port %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving ExpressRoute Port %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ExpressRoute Port %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	// the Admin State of the Links can only be set once the Port exists
+	if d.IsNewResource() && read.ExpressRoutePortPropertiesFormat != nil && read.ExpressRoutePortPropertiesFormat.Links != nil {
+		links := *read.ExpressRoutePortPropertiesFormat.Links
+		requiresUpdate := false
+		for i, key := range []string{"link1", "link2"} {
+			if i >= len(links) || links[i].ExpressRouteLinkPropertiesFormat == nil {
+				continue
+			}
+
+			adminState := expandArmExpressRoutePortLinkAdminState(d.Get(key).([]interface{}))
+			if links[i].ExpressRouteLinkPropertiesFormat.AdminState != adminState {
+				links[i].ExpressRouteLinkPropertiesFormat.AdminState = adminState
+				requiresUpdate = true
+			}
+		}
+
+		if requiresUpdate {
+			read.ExpressRoutePortPropertiesFormat.Links = &links
+
+			future, err := client.CreateOrUpdate(ctx, resourceGroup, name, read)
+			if err != nil {
+				return fmt.Errorf("Error updating the Links for ExpressRoute Port %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("Error waiting for the Links for ExpressRoute Port %q (Resource Group %q) to be updated: %+v", name, resourceGroup, err)
+			}
+		}
+	}
+
+	return resourceArmExpressRoutePortRead(d, meta)
+}
+
+func resourceArmExpressRoutePortRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().expressRoutePortsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseExpressRoutePortID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] ExpressRoute Port %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving ExpressRoute Port %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.ExpressRoutePortPropertiesFormat; props != nil {
+		d.Set("peering_location", props.PeeringLocation)
+		d.Set("encapsulation", string(props.Encapsulation))
+		d.Set("ethertype", props.EtherType)
+		d.Set("mtu", props.Mtu)
+		d.Set("guid", props.ResourceGUID)
+
+		bandwidth := 0
+		if props.BandwidthInGbps != nil {
+			bandwidth = int(*props.BandwidthInGbps)
+		}
+		d.Set("bandwidth_in_gbps", bandwidth)
+
+		links := make([]network.ExpressRouteLink, 0)
+		if props.Links != nil {
+			links = *props.Links
+		}
+		for i, key := range []string{"link1", "link2"} {
+			var link *network.ExpressRouteLink
+			if i < len(links) {
+				link = &links[i]
+			}
+
+			if err := d.Set(key, flattenArmExpressRoutePortLink(link)); err != nil {
+				return fmt.Errorf("Error setting `%s`: %+v", key, err)
+			}
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmExpressRoutePortDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().expressRoutePortsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseExpressRoutePortID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting ExpressRoute Port %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of ExpressRoute Port %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmExpressRoutePortLinkAdminState(input []interface{}) network.ExpressRouteLinkAdminState {
+	if len(input) == 0 || input[0] == nil {
+		return network.ExpressRouteLinkAdminStateDisabled
+	}
+
+	v := input[0].(map[string]interface{})
+	if v["admin_enabled"].(bool) {
+		return network.ExpressRouteLinkAdminStateEnabled
+	}
+
+	return network.ExpressRouteLinkAdminStateDisabled
+}
+
+func flattenArmExpressRoutePortLink(input *network.ExpressRouteLink) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	id := ""
+	if input.ID != nil {
+		id = *input.ID
+	}
+
+	adminEnabled := false
+	routerName := ""
+	interfaceName := ""
+	patchPanelId := ""
+	rackId := ""
+	connectorType := ""
+	if props := input.ExpressRouteLinkPropertiesFormat; props != nil {
+		adminEnabled = props.AdminState == network.ExpressRouteLinkAdminStateEnabled
+		connectorType = string(props.ConnectorType)
+
+		if props.RouterName != nil {
+			routerName = *props.RouterName
+		}
+		if props.InterfaceName != nil {
+			interfaceName = *props.InterfaceName
+		}
+		if props.PatchPanelID != nil {
+			patchPanelId = *props.PatchPanelID
+		}
+		if props.RackID != nil {
+			rackId = *props.RackID
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"admin_enabled":  adminEnabled,
+			"id":             id,
+			"router_name":    routerName,
+			"interface_name": interfaceName,
+			"patch_panel_id": patchPanelId,
+			"rack_id":        rackId,
+			"connector_type": connectorType,
+		},
+	}
+}
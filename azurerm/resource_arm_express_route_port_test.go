@@ -0,0 +1,218 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMExpressRoutePort_basic(t *testing.T) {
+	resourceName := "azurerm_express_route_port.test"
+	ri := testAccRandTimeInt(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMExpressRoutePortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMExpressRoutePort_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRoutePortExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "link1.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "link2.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "guid"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMExpressRoutePort_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_express_route_port.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMExpressRoutePortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMExpressRoutePort_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRoutePortExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMExpressRoutePort_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_express_route_port"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMExpressRoutePort_linkAdminState(t *testing.T) {
+	resourceName := "azurerm_express_route_port.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMExpressRoutePortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMExpressRoutePort_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRoutePortExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "link1.0.admin_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "link2.0.admin_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMExpressRoutePort_linksEnabled(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRoutePortExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "link1.0.admin_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "link2.0.admin_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMExpressRoutePortExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).network().expressRoutePortsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: ExpressRoute Port %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on expressRoutePortsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMExpressRoutePortDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network().expressRoutePortsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_express_route_port" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("ExpressRoute Port %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMExpressRoutePort_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_express_route_port" "test" {
+  name                = "acctesterp%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  peering_location    = "Airtel-Chennai-CLS"
+  bandwidth_in_gbps   = 10
+  encapsulation       = "Dot1Q"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMExpressRoutePort_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_port" "import" {
+  name                = "${azurerm_express_route_port.test.name}"
+  resource_group_name = "${azurerm_express_route_port.test.resource_group_name}"
+  location            = "${azurerm_express_route_port.test.location}"
+  peering_location    = "${azurerm_express_route_port.test.peering_location}"
+  bandwidth_in_gbps   = "${azurerm_express_route_port.test.bandwidth_in_gbps}"
+  encapsulation       = "${azurerm_express_route_port.test.encapsulation}"
+}
+`, testAccAzureRMExpressRoutePort_basic(rInt, location))
+}
+
+func testAccAzureRMExpressRoutePort_linksEnabled(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_express_route_port" "test" {
+  name                = "acctesterp%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  peering_location    = "Airtel-Chennai-CLS"
+  bandwidth_in_gbps   = 10
+  encapsulation       = "Dot1Q"
+
+  link1 {
+    admin_enabled = true
+  }
+
+  link2 {
+    admin_enabled = true
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, rInt, location, rInt)
+}
@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -25,6 +27,13 @@ func resourceArmFirewall() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -40,7 +49,7 @@ func resourceArmFirewall() *schema.Resource {
 			"ip_configuration": {
 				Type:     schema.TypeList,
 				Required: true,
-				MaxItems: 1,
+				MinItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -50,23 +59,21 @@ func resourceArmFirewall() *schema.Resource {
 						},
 						"subnet_id": {
 							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Optional:     true,
+							ValidateFunc: ids.ValidateSubnetID,
 						},
 						"internal_public_ip_address_id": {
-							Type:          schema.TypeString,
-							Optional:      true,
-							Computed:      true,
-							ValidateFunc:  azure.ValidateResourceID,
-							Deprecated:    "This field has been deprecated. Use `public_ip_address_id` instead.",
-							ConflictsWith: []string{"ip_configuration.0.public_ip_address_id"},
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: ids.ValidatePublicIPAddressID,
+							Deprecated:   "This field has been deprecated. Use `public_ip_address_id` instead.",
 						},
 						"public_ip_address_id": {
-							Type:          schema.TypeString,
-							Optional:      true,
-							Computed:      true,
-							ValidateFunc:  azure.ValidateResourceID,
-							ConflictsWith: []string{"ip_configuration.0.internal_public_ip_address_id"},
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: ids.ValidatePublicIPAddressID,
 						},
 						"private_ip_address": {
 							Type:     schema.TypeString,
@@ -82,8 +89,9 @@ func resourceArmFirewall() *schema.Resource {
 }
 
 func resourceArmFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().azureFirewallsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Azure Firewall creation")
 
@@ -168,8 +176,9 @@ func resourceArmFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmFirewallRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().azureFirewallsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -208,8 +217,9 @@ func resourceArmFirewallRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceArmFirewallDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().azureFirewallsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -283,46 +293,69 @@ func expandArmFirewallIPConfigurations(d *schema.ResourceData) (*[]network.Azure
 	subnetNamesToLock := make([]string, 0)
 	virtualNetworkNamesToLock := make([]string, 0)
 
-	for _, configRaw := range configs {
+	for i, configRaw := range configs {
 		data := configRaw.(map[string]interface{})
 		name := data["name"].(string)
 		subnetId := data["subnet_id"].(string)
 
-		pubID, exist := data["internal_public_ip_address_id"].(string)
-		if !exist || pubID == "" {
-			pubID, exist = data["public_ip_address_id"].(string)
-		}
+		// both fields are Computed from the same value, so these can only differ when both have been changed
+		// (which is only possible when both are set) - otherwise we use whichever one has been changed
+		pubID := data["public_ip_address_id"].(string)
+		if v := data["internal_public_ip_address_id"].(string); v != "" {
+			internalPubIDChanged := d.HasChange(fmt.Sprintf("ip_configuration.%d.internal_public_ip_address_id", i))
+			pubIDChanged := d.HasChange(fmt.Sprintf("ip_configuration.%d.public_ip_address_id", i))
+			if pubID != "" && pubID != v && internalPubIDChanged && pubIDChanged {
+				return nil, nil, nil, fmt.Errorf("only one of `ip_configuration.%d.internal_public_ip_address_id` or `ip_configuration.%d.public_ip_address_id` can be set", i, i)
+			}
 
-		if !exist || pubID == "" {
-			return nil, nil, nil, fmt.Errorf("one of `ip_configuration.0.internal_public_ip_address_id` or `ip_configuration.0.public_ip_address_id` must be set")
+			if pubID == "" || internalPubIDChanged {
+				pubID = v
+			}
 		}
 
-		subnetID, err := parseAzureResourceID(subnetId)
-		if err != nil {
-			return nil, nil, nil, err
+		if pubID == "" {
+			return nil, nil, nil, fmt.Errorf("one of `ip_configuration.%d.internal_public_ip_address_id` or `ip_configuration.%d.public_ip_address_id` must be set", i, i)
 		}
 
-		subnetName := subnetID.Path["subnets"]
-		virtualNetworkName := subnetID.Path["virtualNetworks"]
+		// only the first IP Configuration is attached to the Subnet, the rest only provide additional Public IP's
+		if i == 0 && subnetId == "" {
+			return nil, nil, nil, fmt.Errorf("`ip_configuration.0.subnet_id` must be set")
+		}
+		if i > 0 && subnetId != "" {
+			return nil, nil, nil, fmt.Errorf("`ip_configuration.%d.subnet_id` cannot be set - only the first `ip_configuration` block can specify a `subnet_id`", i)
+		}
 
-		if !sliceContainsValue(subnetNamesToLock, subnetName) {
-			subnetNamesToLock = append(subnetNamesToLock, subnetName)
+		properties := network.AzureFirewallIPConfigurationPropertiesFormat{
+			PublicIPAddress: &network.SubResource{
+				ID: utils.String(pubID),
+			},
 		}
 
-		if !sliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
-			virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
+		if subnetId != "" {
+			subnetID, err := parseAzureResourceID(subnetId)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+
+			subnetName := subnetID.Path["subnets"]
+			virtualNetworkName := subnetID.Path["virtualNetworks"]
+
+			if !sliceContainsValue(subnetNamesToLock, subnetName) {
+				subnetNamesToLock = append(subnetNamesToLock, subnetName)
+			}
+
+			if !sliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
+				virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
+			}
+
+			properties.Subnet = &network.SubResource{
+				ID: utils.String(subnetId),
+			}
 		}
 
 		ipConfig := network.AzureFirewallIPConfiguration{
 			Name: utils.String(name),
-			AzureFirewallIPConfigurationPropertiesFormat: &network.AzureFirewallIPConfigurationPropertiesFormat{
-				Subnet: &network.SubResource{
-					ID: utils.String(subnetId),
-				},
-				PublicIPAddress: &network.SubResource{
-					ID: utils.String(pubID),
-				},
-			},
+			AzureFirewallIPConfigurationPropertiesFormat: &properties,
 		}
 		ipConfigs = append(ipConfigs, ipConfig)
 	}
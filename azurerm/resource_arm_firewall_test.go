@@ -5,7 +5,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -47,6 +49,89 @@ func TestValidateFirewallName(t *testing.T) {
 	}
 }
 
+func TestExpandArmFirewallIPConfigurations(t *testing.T) {
+	subnetId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/AzureFirewallSubnet"
+	publicIPAddressId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/"
+
+	testData := []struct {
+		name             string
+		ipConfigurations []interface{}
+		expectedIds      []string
+		expectError      bool
+	}{
+		{
+			name: "deprecated field in a subsequent block",
+			ipConfigurations: []interface{}{
+				map[string]interface{}{
+					"name":                 "first",
+					"subnet_id":            subnetId,
+					"public_ip_address_id": publicIPAddressId + "ip1",
+				},
+				map[string]interface{}{
+					"name":                          "second",
+					"internal_public_ip_address_id": publicIPAddressId + "ip2",
+				},
+			},
+			expectedIds: []string{publicIPAddressId + "ip1", publicIPAddressId + "ip2"},
+		},
+		{
+			name: "both fields in a subsequent block",
+			ipConfigurations: []interface{}{
+				map[string]interface{}{
+					"name":                 "first",
+					"subnet_id":            subnetId,
+					"public_ip_address_id": publicIPAddressId + "ip1",
+				},
+				map[string]interface{}{
+					"name":                          "second",
+					"public_ip_address_id":          publicIPAddressId + "ip2",
+					"internal_public_ip_address_id": publicIPAddressId + "ip3",
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"name":                "example",
+				"location":            "westeurope",
+				"resource_group_name": "group1",
+				"ip_configuration":    v.ipConfigurations,
+			}
+
+			rawConfig, err := config.NewRawConfig(raw)
+			if err != nil {
+				t.Fatalf("Error building the config: %+v", err)
+			}
+			if _, errors := resourceArmFirewall().Validate(terraform.NewResourceConfig(rawConfig)); len(errors) > 0 {
+				t.Fatalf("Expected the config to be valid but got %+v", errors)
+			}
+
+			d := schema.TestResourceDataRaw(t, resourceArmFirewall().Schema, raw)
+			ipConfigs, _, _, err := expandArmFirewallIPConfigurations(d)
+			if v.expectError {
+				if err == nil {
+					t.Fatalf("Expected an error but didn't get one")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Error expanding the IP Configurations: %+v", err)
+			}
+
+			actualIds := make([]string, 0)
+			for _, ipConfig := range *ipConfigs {
+				actualIds = append(actualIds, *ipConfig.AzureFirewallIPConfigurationPropertiesFormat.PublicIPAddress.ID)
+			}
+			if strings.Join(actualIds, ",") != strings.Join(v.expectedIds, ",") {
+				t.Fatalf("Expected the Public IP Address IDs %+v but got %+v", v.expectedIds, actualIds)
+			}
+		})
+	}
+}
+
 func TestAccAzureRMFirewall_basicOld(t *testing.T) {
 	resourceName := "azurerm_firewall.test"
 	ri := tf.AccRandTimeInt()
@@ -109,6 +194,50 @@ func TestAccAzureRMFirewall_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMFirewall_withMultiplePublicIPs(t *testing.T) {
+	resourceName := "azurerm_firewall.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewall_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ip_configuration.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMFirewall_multiplePublicIps(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ip_configuration.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ip_configuration.0.name", "configuration"),
+					resource.TestCheckResourceAttrSet(resourceName, "ip_configuration.0.private_ip_address"),
+					resource.TestCheckResourceAttr(resourceName, "ip_configuration.1.name", "configuration_2"),
+					resource.TestCheckResourceAttr(resourceName, "ip_configuration.1.subnet_id", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMFirewall_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ip_configuration.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMFirewall_requiresImport(t *testing.T) {
 	if !requireResourcesToBeImported {
 		t.Skip("Skipping since resources aren't required to be imported")
@@ -211,7 +340,7 @@ func testCheckAzureRMFirewallExists(resourceName string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for Azure Firewall: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).azureFirewallsClient
+		client := testAccProvider.Meta().(*ArmClient).network().azureFirewallsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
@@ -240,7 +369,7 @@ func testCheckAzureRMFirewallDisappears(resourceName string) resource.TestCheckF
 			return fmt.Errorf("Bad: no resource group found in state for Azure Firewall: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).azureFirewallsClient
+		client := testAccProvider.Meta().(*ArmClient).network().azureFirewallsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		future, err := client.Delete(ctx, resourceGroup, name)
 		if err != nil {
@@ -255,7 +384,7 @@ func testCheckAzureRMFirewallDisappears(resourceName string) resource.TestCheckF
 }
 
 func testCheckAzureRMFirewallDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).azureFirewallsClient
+	client := testAccProvider.Meta().(*ArmClient).network().azureFirewallsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -386,6 +515,62 @@ resource "azurerm_firewall" "import" {
 `, template)
 }
 
+func testAccAzureRMFirewall_multiplePublicIps(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "AzureFirewallSubnet"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_public_ip" "test_2" {
+  name                = "acctestpip2%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_firewall" "test" {
+  name                = "acctestfirewall%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                 = "configuration"
+    subnet_id            = "${azurerm_subnet.test.id}"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+
+  ip_configuration {
+    name                 = "configuration_2"
+    public_ip_address_id = "${azurerm_public_ip.test_2.id}"
+  }
+}
+`, rInt, location, rInt, rInt, rInt, rInt)
+}
+
 func testAccAzureRMFirewall_withTags(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
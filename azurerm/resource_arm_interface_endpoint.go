@@ -0,0 +1,329 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var interfaceEndpointResourceName = "azurerm_interface_endpoint"
+
+func resourceArmInterfaceEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmInterfaceEndpointCreateUpdate,
+		Read:   resourceArmInterfaceEndpointRead,
+		Update: resourceArmInterfaceEndpointCreateUpdate,
+		Delete: resourceArmInterfaceEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ids.ValidateSubnetID,
+			},
+
+			"fqdn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validate.NoEmptyStrings,
+				ConflictsWith: []string{"endpoint_service_id"},
+			},
+
+			"endpoint_service_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validate.NoEmptyStrings,
+				ConflictsWith: []string{"fqdn"},
+			},
+
+			"network_interface_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"private_ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmInterfaceEndpointCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().interfaceEndpointsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Interface Endpoint creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Interface Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_interface_endpoint", *existing.ID)
+		}
+	}
+
+	fqdn := d.Get("fqdn").(string)
+	endpointServiceId := d.Get("endpoint_service_id").(string)
+	if d.IsNewResource() && fqdn == "" && endpointServiceId == "" {
+		return fmt.Errorf("One of `fqdn` or `endpoint_service_id` must be specified")
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	subnetId, err := ids.ParseSubnetID(d.Get("subnet_id").(string))
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(name, interfaceEndpointResourceName)
+	defer azureRMUnlockByName(name, interfaceEndpointResourceName)
+
+	azureRMLockByName(subnetId.Name, subnetResourceName)
+	defer azureRMUnlockByName(subnetId.Name, subnetResourceName)
+
+	azureRMLockByName(subnetId.VirtualNetworkName, virtualNetworkResourceName)
+	defer azureRMUnlockByName(subnetId.VirtualNetworkName, virtualNetworkResourceName)
+
+	properties := network.InterfaceEndpointProperties{
+		Subnet: &network.Subnet{
+			ID: utils.String(subnetId.String()),
+		},
+	}
+	if fqdn != "" {
+		properties.Fqdn = utils.String(fqdn)
+	}
+	if endpointServiceId != "" {
+		properties.EndpointService = &network.EndpointService{
+			ID: utils.String(endpointServiceId),
+		}
+	}
+
+	parameters := network.InterfaceEndpoint{
+		Location:                    utils.String(location),
+		InterfaceEndpointProperties: &properties,
+		Tags:                        expandTags(tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Interface Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Interface Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Interface Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Interface Endpoint %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmInterfaceEndpointRead(d, meta)
+}
+
+func resourceArmInterfaceEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().interfaceEndpointsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseInterfaceEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Interface Endpoint %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Interface Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.InterfaceEndpointProperties; props != nil {
+		d.Set("fqdn", props.Fqdn)
+		d.Set("owner", props.Owner)
+
+		subnetId := ""
+		if subnet := props.Subnet; subnet != nil && subnet.ID != nil {
+			subnetId = *subnet.ID
+		}
+		d.Set("subnet_id", subnetId)
+
+		endpointServiceId := ""
+		if service := props.EndpointService; service != nil && service.ID != nil {
+			endpointServiceId = *service.ID
+		}
+		d.Set("endpoint_service_id", endpointServiceId)
+
+		interfaceIds, privateIPAddresses, err := retrieveArmInterfaceEndpointNetworkInterfaces(ctx, meta.(*ArmClient).network().ifaceClient, props.NetworkInterfaces)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("network_interface_ids", interfaceIds); err != nil {
+			return fmt.Errorf("Error setting `network_interface_ids`: %+v", err)
+		}
+		if err := d.Set("private_ip_addresses", privateIPAddresses); err != nil {
+			return fmt.Errorf("Error setting `private_ip_addresses`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmInterfaceEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().interfaceEndpointsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseInterfaceEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	subnetId, err := ids.ParseSubnetID(d.Get("subnet_id").(string))
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(name, interfaceEndpointResourceName)
+	defer azureRMUnlockByName(name, interfaceEndpointResourceName)
+
+	azureRMLockByName(subnetId.Name, subnetResourceName)
+	defer azureRMUnlockByName(subnetId.Name, subnetResourceName)
+
+	azureRMLockByName(subnetId.VirtualNetworkName, virtualNetworkResourceName)
+	defer azureRMUnlockByName(subnetId.VirtualNetworkName, virtualNetworkResourceName)
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Interface Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Interface Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// the Interface Endpoint only returns references to the Network Interfaces it creates,
+// so we look each one up to find the Private IP Addresses assigned to them
+func retrieveArmInterfaceEndpointNetworkInterfaces(ctx context.Context, client network.InterfacesClient, input *[]network.Interface) ([]interface{}, []interface{}, error) {
+	interfaceIds := make([]interface{}, 0)
+	privateIPAddresses := make([]interface{}, 0)
+	if input == nil {
+		return interfaceIds, privateIPAddresses, nil
+	}
+
+	for _, iface := range *input {
+		if iface.ID == nil {
+			continue
+		}
+		interfaceIds = append(interfaceIds, *iface.ID)
+
+		id, err := ids.ParseNetworkInterfaceID(*iface.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		if props := resp.InterfacePropertiesFormat; props != nil && props.IPConfigurations != nil {
+			for _, config := range *props.IPConfigurations {
+				if configProps := config.InterfaceIPConfigurationPropertiesFormat; configProps != nil && configProps.PrivateIPAddress != nil {
+					privateIPAddresses = append(privateIPAddresses, *configProps.PrivateIPAddress)
+				}
+			}
+		}
+	}
+
+	return interfaceIds, privateIPAddresses, nil
+}
@@ -0,0 +1,233 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMInterfaceEndpoint_basic(t *testing.T) {
+	resourceName := "azurerm_interface_endpoint.test"
+	ri := testAccRandTimeInt(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMInterfaceEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMInterfaceEndpoint_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMInterfaceEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "network_interface_ids.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_ip_addresses.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMInterfaceEndpoint_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_interface_endpoint.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMInterfaceEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMInterfaceEndpoint_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMInterfaceEndpointExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMInterfaceEndpoint_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_interface_endpoint"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMInterfaceEndpoint_withTags(t *testing.T) {
+	resourceName := "azurerm_interface_endpoint.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMInterfaceEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMInterfaceEndpoint_withTags(ri, location, "Production"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMInterfaceEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+				),
+			},
+			{
+				Config: testAccAzureRMInterfaceEndpoint_withTags(ri, location, "Staging"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMInterfaceEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Staging"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMInterfaceEndpointExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		id, err := ids.ParseInterfaceEndpointID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).network().interfaceEndpointsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Interface Endpoint %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on interfaceEndpointsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMInterfaceEndpointDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network().interfaceEndpointsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_interface_endpoint" {
+			continue
+		}
+
+		id, err := ids.ParseInterfaceEndpointID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Interface Endpoint %q (Resource Group %q) still exists", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMInterfaceEndpoint_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.1.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.1.0.0/24"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMInterfaceEndpoint_basic(rInt int, location string) string {
+	template := testAccAzureRMInterfaceEndpoint_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_interface_endpoint" "test" {
+  name                = "acctestinterfaceendpoint-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  subnet_id           = "${azurerm_subnet.test.id}"
+  fqdn                = "${azurerm_sql_server.test.fully_qualified_domain_name}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMInterfaceEndpoint_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_interface_endpoint" "import" {
+  name                = "${azurerm_interface_endpoint.test.name}"
+  location            = "${azurerm_interface_endpoint.test.location}"
+  resource_group_name = "${azurerm_interface_endpoint.test.resource_group_name}"
+  subnet_id           = "${azurerm_interface_endpoint.test.subnet_id}"
+  fqdn                = "${azurerm_interface_endpoint.test.fqdn}"
+}
+`, testAccAzureRMInterfaceEndpoint_basic(rInt, location))
+}
+
+func testAccAzureRMInterfaceEndpoint_withTags(rInt int, location string, environment string) string {
+	template := testAccAzureRMInterfaceEndpoint_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_interface_endpoint" "test" {
+  name                = "acctestinterfaceendpoint-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  subnet_id           = "${azurerm_subnet.test.id}"
+  fqdn                = "${azurerm_sql_server.test.fully_qualified_domain_name}"
+
+  tags = {
+    environment = "%s"
+  }
+}
+`, template, rInt, environment)
+}
@@ -186,7 +186,7 @@ func testCheckAzureRMIotHubSharedAccessPolicyExists(resourceName string) resourc
 		keyName := parsedIothubId.Path["IotHubKeys"]
 		resourceGroup := parsedIothubId.ResourceGroup
 
-		client := testAccProvider.Meta().(*ArmClient).iothubResourceClient
+		client := testAccProvider.Meta().(*ArmClient).devices().iothubResourceClient
 
 		for accessPolicyIterator, err := client.ListKeysComplete(ctx, resourceGroup, iothubName); accessPolicyIterator.NotDone(); err = accessPolicyIterator.NextWithContext(ctx) {
 			if err != nil {
@@ -204,7 +204,7 @@ func testCheckAzureRMIotHubSharedAccessPolicyExists(resourceName string) resourc
 }
 
 func testCheckAzureRMIotHubSharedAccessPolicyDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).iothubResourceClient
+	client := testAccProvider.Meta().(*ArmClient).devices().iothubResourceClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -562,7 +562,7 @@ func testCheckAzureRMKubernetesClusterExists(resourceName string) resource.TestC
 			return fmt.Errorf("Bad: no resource group found in state for Managed Kubernetes Cluster: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).kubernetesClustersClient
+		client := testAccProvider.Meta().(*ArmClient).containerServices().kubernetesClustersClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		aks, err := client.Get(ctx, resourceGroup, name)
@@ -579,7 +579,7 @@ func testCheckAzureRMKubernetesClusterExists(resourceName string) resource.TestC
 }
 
 func testCheckAzureRMKubernetesClusterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).kubernetesClustersClient
+	conn := testAccProvider.Meta().(*ArmClient).containerServices().kubernetesClustersClient
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_kubernetes_cluster" {
@@ -3,13 +3,15 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -25,6 +27,13 @@ func resourceArmLoadBalancer() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -64,7 +73,7 @@ func resourceArmLoadBalancer() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							Computed:     true,
-							ValidateFunc: azure.ValidateResourceIDOrEmpty,
+							ValidateFunc: ids.ValidateOrEmpty(ids.ValidateSubnetID),
 						},
 
 						"private_ip_address": {
@@ -78,7 +87,7 @@ func resourceArmLoadBalancer() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							Computed:     true,
-							ValidateFunc: azure.ValidateResourceIDOrEmpty,
+							ValidateFunc: ids.ValidateOrEmpty(ids.ValidatePublicIPAddressID),
 						},
 
 						"private_ip_address_allocation": {
@@ -128,6 +137,292 @@ func resourceArmLoadBalancer() *schema.Resource {
 				},
 			},
 
+			"backend_address_pool": {
+				Type:       schema.TypeList,
+				ConfigMode: schema.SchemaConfigModeAttr,
+				Optional:   true,
+				Computed:   true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"probe": {
+				Type:       schema.TypeList,
+				ConfigMode: schema.SchemaConfigModeAttr,
+				Optional:   true,
+				Computed:   true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"protocol": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							StateFunc:        ignoreCaseStateFunc,
+							DiffSuppressFunc: suppress.CaseDifference,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.ProbeProtocolHTTP),
+								string(network.ProbeProtocolHTTPS),
+								string(network.ProbeProtocolTCP),
+							}, true),
+						},
+
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validate.PortNumber,
+						},
+
+						"request_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"interval_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      15,
+							ValidateFunc: validation.IntAtLeast(5),
+						},
+
+						"number_of_probes": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  2,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"rule": {
+				Type:       schema.TypeList,
+				ConfigMode: schema.SchemaConfigModeAttr,
+				Optional:   true,
+				Computed:   true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArmLoadBalancerRuleName,
+						},
+
+						"frontend_ip_configuration_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"backend_address_pool_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"probe_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"protocol": {
+							Type:             schema.TypeString,
+							Required:         true,
+							StateFunc:        ignoreCaseStateFunc,
+							DiffSuppressFunc: suppress.CaseDifference,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.TransportProtocolAll),
+								string(network.TransportProtocolTCP),
+								string(network.TransportProtocolUDP),
+							}, true),
+						},
+
+						"frontend_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validate.PortNumberOrZero,
+						},
+
+						"backend_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validate.PortNumberOrZero,
+						},
+
+						"enable_floating_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"idle_timeout_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      4,
+							ValidateFunc: validation.IntBetween(4, 30),
+						},
+
+						"load_distribution": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(network.LoadDistributionDefault),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.LoadDistributionDefault),
+								string(network.LoadDistributionSourceIP),
+								string(network.LoadDistributionSourceIPProtocol),
+							}, false),
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"nat_rule": {
+				Type:       schema.TypeList,
+				ConfigMode: schema.SchemaConfigModeAttr,
+				Optional:   true,
+				Computed:   true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"frontend_ip_configuration_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"protocol": {
+							Type:             schema.TypeString,
+							Required:         true,
+							StateFunc:        ignoreCaseStateFunc,
+							DiffSuppressFunc: suppress.CaseDifference,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.TransportProtocolAll),
+								string(network.TransportProtocolTCP),
+								string(network.TransportProtocolUDP),
+							}, true),
+						},
+
+						"frontend_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validate.PortNumber,
+						},
+
+						"backend_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validate.PortNumber,
+						},
+
+						"enable_floating_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"outbound_rule": {
+				Type:       schema.TypeList,
+				ConfigMode: schema.SchemaConfigModeAttr,
+				Optional:   true,
+				Computed:   true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"frontend_ip_configuration_names": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+
+						"backend_address_pool_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.Protocol1All),
+								string(network.Protocol1TCP),
+								string(network.Protocol1UDP),
+							}, false),
+						},
+
+						"enable_tcp_reset": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"allocated_outbound_ports": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1024,
+						},
+
+						"idle_timeout_in_minutes": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  4,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"private_ip_address": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -146,8 +441,9 @@ func resourceArmLoadBalancer() *schema.Resource {
 }
 
 func resourceArmLoadBalancerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).loadBalancerClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().loadBalancerClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Load Balancer creation.")
 
@@ -176,10 +472,53 @@ func resourceArmLoadBalancerCreateUpdate(d *schema.ResourceData, meta interface{
 
 	properties := network.LoadBalancerPropertiesFormat{}
 
+	if !d.IsNewResource() {
+		// the standalone Load Balancer resources (e.g. `azurerm_lb_rule`) also update this Load Balancer,
+		// so we lock on its ID and only replace the child collections which have changed in-line
+		armMutexKV.Lock(d.Id())
+		defer armMutexKV.Unlock(d.Id())
+
+		existing, err := client.Get(ctx, resGroup, name, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving Load Balancer %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if props := existing.LoadBalancerPropertiesFormat; props != nil {
+			properties.BackendAddressPools = props.BackendAddressPools
+			properties.Probes = props.Probes
+			properties.LoadBalancingRules = props.LoadBalancingRules
+			properties.InboundNatRules = props.InboundNatRules
+			properties.InboundNatPools = props.InboundNatPools
+			properties.OutboundRules = props.OutboundRules
+		}
+	}
+
 	if _, ok := d.GetOk("frontend_ip_configuration"); ok {
 		properties.FrontendIPConfigurations = expandAzureRmLoadBalancerFrontendIpConfigurations(d)
 	}
 
+	loadBalancerId := ids.NewLoadBalancerID(meta.(*ArmClient).subscriptionId, resGroup, name)
+
+	if d.HasChange("backend_address_pool") {
+		properties.BackendAddressPools = expandAzureRmLoadBalancerInlineBackendAddressPools(d.Get("backend_address_pool").([]interface{}))
+	}
+
+	if d.HasChange("probe") {
+		properties.Probes = expandAzureRmLoadBalancerInlineProbes(d.Get("probe").([]interface{}))
+	}
+
+	if d.HasChange("rule") {
+		properties.LoadBalancingRules = expandAzureRmLoadBalancerInlineRules(d.Get("rule").([]interface{}), loadBalancerId)
+	}
+
+	if d.HasChange("nat_rule") {
+		properties.InboundNatRules = expandAzureRmLoadBalancerInlineNatRules(d.Get("nat_rule").([]interface{}), loadBalancerId)
+	}
+
+	if d.HasChange("outbound_rule") {
+		properties.OutboundRules = expandAzureRmLoadBalancerInlineOutboundRules(d.Get("outbound_rule").([]interface{}), loadBalancerId)
+	}
+
 	loadBalancer := network.LoadBalancer{
 		Name:                         utils.String(name),
 		Location:                     utils.String(location),
@@ -211,12 +550,15 @@ func resourceArmLoadBalancerCreateUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceArmLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	loadBalancer, exists, err := retrieveLoadBalancerById(d.Id(), meta)
+	loadBalancer, exists, err := retrieveLoadBalancerById(ctx, d.Id(), meta)
 	if err != nil {
 		return fmt.Errorf("Error retrieving Load Balancer by ID %q: %+v", d.Id(), err)
 	}
@@ -259,6 +601,38 @@ func resourceArmLoadBalancerRead(d *schema.ResourceData, meta interface{}) error
 			d.Set("private_ip_address", privateIpAddress)
 			d.Set("private_ip_addresses", privateIpAddresses)
 		}
+
+		if err := d.Set("backend_address_pool", flattenAzureRmLoadBalancerInlineBackendAddressPools(props.BackendAddressPools)); err != nil {
+			return fmt.Errorf("Error setting `backend_address_pool`: %+v", err)
+		}
+
+		if err := d.Set("probe", flattenAzureRmLoadBalancerInlineProbes(props.Probes)); err != nil {
+			return fmt.Errorf("Error setting `probe`: %+v", err)
+		}
+
+		rules, err := flattenAzureRmLoadBalancerInlineRules(props.LoadBalancingRules)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("rule", rules); err != nil {
+			return fmt.Errorf("Error setting `rule`: %+v", err)
+		}
+
+		natRules, err := flattenAzureRmLoadBalancerInlineNatRules(props.InboundNatRules)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("nat_rule", natRules); err != nil {
+			return fmt.Errorf("Error setting `nat_rule`: %+v", err)
+		}
+
+		outboundRules, err := flattenAzureRmLoadBalancerInlineOutboundRules(props.OutboundRules)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("outbound_rule", outboundRules); err != nil {
+			return fmt.Errorf("Error setting `outbound_rule`: %+v", err)
+		}
 	}
 
 	flattenAndSetTags(d, loadBalancer.Tags)
@@ -267,8 +641,9 @@ func resourceArmLoadBalancerRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).loadBalancerClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().loadBalancerClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -396,3 +771,391 @@ func flattenLoadBalancerFrontendIpConfiguration(ipConfigs *[]network.FrontendIPC
 	}
 	return result
 }
+
+func expandAzureRmLoadBalancerInlineBackendAddressPools(input []interface{}) *[]network.BackendAddressPool {
+	pools := make([]network.BackendAddressPool, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		pools = append(pools, network.BackendAddressPool{
+			Name: utils.String(v["name"].(string)),
+		})
+	}
+
+	return &pools
+}
+
+func flattenAzureRmLoadBalancerInlineBackendAddressPools(input *[]network.BackendAddressPool) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, pool := range *input {
+		result := make(map[string]interface{})
+
+		if pool.Name != nil {
+			result["name"] = *pool.Name
+		}
+
+		if pool.ID != nil {
+			result["id"] = *pool.ID
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func expandAzureRmLoadBalancerInlineProbes(input []interface{}) *[]network.Probe {
+	probes := make([]network.Probe, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		properties := network.ProbePropertiesFormat{
+			NumberOfProbes:    utils.Int32(int32(v["number_of_probes"].(int))),
+			IntervalInSeconds: utils.Int32(int32(v["interval_in_seconds"].(int))),
+			Port:              utils.Int32(int32(v["port"].(int))),
+		}
+
+		if protocol := v["protocol"].(string); protocol != "" {
+			properties.Protocol = network.ProbeProtocol(protocol)
+		}
+
+		if requestPath := v["request_path"].(string); requestPath != "" {
+			properties.RequestPath = utils.String(requestPath)
+		}
+
+		probes = append(probes, network.Probe{
+			Name:                  utils.String(v["name"].(string)),
+			ProbePropertiesFormat: &properties,
+		})
+	}
+
+	return &probes
+}
+
+func flattenAzureRmLoadBalancerInlineProbes(input *[]network.Probe) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, probe := range *input {
+		result := make(map[string]interface{})
+
+		if probe.Name != nil {
+			result["name"] = *probe.Name
+		}
+
+		if probe.ID != nil {
+			result["id"] = *probe.ID
+		}
+
+		if props := probe.ProbePropertiesFormat; props != nil {
+			result["protocol"] = string(props.Protocol)
+
+			if props.Port != nil {
+				result["port"] = int(*props.Port)
+			}
+
+			if props.RequestPath != nil {
+				result["request_path"] = *props.RequestPath
+			}
+
+			if props.IntervalInSeconds != nil {
+				result["interval_in_seconds"] = int(*props.IntervalInSeconds)
+			}
+
+			if props.NumberOfProbes != nil {
+				result["number_of_probes"] = int(*props.NumberOfProbes)
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// the in-line rules reference the other in-line blocks by name, since the ID's of these
+// don't exist until the Load Balancer has been created - so we build these up here
+func expandAzureRmLoadBalancerInlineRules(input []interface{}, loadBalancerId ids.LoadBalancerID) *[]network.LoadBalancingRule {
+	rules := make([]network.LoadBalancingRule, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		frontendIPConfigurationId := ids.NewLoadBalancerFrontendIPConfigurationID(loadBalancerId.SubscriptionID, loadBalancerId.ResourceGroup, loadBalancerId.Name, v["frontend_ip_configuration_name"].(string))
+		properties := network.LoadBalancingRulePropertiesFormat{
+			FrontendIPConfiguration: &network.SubResource{
+				ID: utils.String(frontendIPConfigurationId.String()),
+			},
+			Protocol:             network.TransportProtocol(v["protocol"].(string)),
+			FrontendPort:         utils.Int32(int32(v["frontend_port"].(int))),
+			BackendPort:          utils.Int32(int32(v["backend_port"].(int))),
+			EnableFloatingIP:     utils.Bool(v["enable_floating_ip"].(bool)),
+			IdleTimeoutInMinutes: utils.Int32(int32(v["idle_timeout_in_minutes"].(int))),
+			LoadDistribution:     network.LoadDistribution(v["load_distribution"].(string)),
+		}
+
+		if poolName := v["backend_address_pool_name"].(string); poolName != "" {
+			poolId := ids.NewLoadBalancerBackendAddressPoolID(loadBalancerId.SubscriptionID, loadBalancerId.ResourceGroup, loadBalancerId.Name, poolName)
+			properties.BackendAddressPool = &network.SubResource{
+				ID: utils.String(poolId.String()),
+			}
+		}
+
+		if probeName := v["probe_name"].(string); probeName != "" {
+			probeId := ids.NewLoadBalancerProbeID(loadBalancerId.SubscriptionID, loadBalancerId.ResourceGroup, loadBalancerId.Name, probeName)
+			properties.Probe = &network.SubResource{
+				ID: utils.String(probeId.String()),
+			}
+		}
+
+		rules = append(rules, network.LoadBalancingRule{
+			Name:                              utils.String(v["name"].(string)),
+			LoadBalancingRulePropertiesFormat: &properties,
+		})
+	}
+
+	return &rules
+}
+
+func flattenAzureRmLoadBalancerInlineRules(input *[]network.LoadBalancingRule) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results, nil
+	}
+
+	for _, rule := range *input {
+		result := make(map[string]interface{})
+
+		if rule.Name != nil {
+			result["name"] = *rule.Name
+		}
+
+		if rule.ID != nil {
+			result["id"] = *rule.ID
+		}
+
+		if props := rule.LoadBalancingRulePropertiesFormat; props != nil {
+			result["protocol"] = string(props.Protocol)
+			result["load_distribution"] = string(props.LoadDistribution)
+
+			if props.FrontendPort != nil {
+				result["frontend_port"] = int(*props.FrontendPort)
+			}
+
+			if props.BackendPort != nil {
+				result["backend_port"] = int(*props.BackendPort)
+			}
+
+			if props.EnableFloatingIP != nil {
+				result["enable_floating_ip"] = *props.EnableFloatingIP
+			}
+
+			if props.IdleTimeoutInMinutes != nil {
+				result["idle_timeout_in_minutes"] = int(*props.IdleTimeoutInMinutes)
+			}
+
+			if config := props.FrontendIPConfiguration; config != nil && config.ID != nil {
+				id, err := ids.ParseLoadBalancerFrontendIPConfigurationID(*config.ID)
+				if err != nil {
+					return nil, err
+				}
+				result["frontend_ip_configuration_name"] = id.Name
+			}
+
+			if pool := props.BackendAddressPool; pool != nil && pool.ID != nil {
+				id, err := ids.ParseLoadBalancerBackendAddressPoolID(*pool.ID)
+				if err != nil {
+					return nil, err
+				}
+				result["backend_address_pool_name"] = id.Name
+			}
+
+			if probe := props.Probe; probe != nil && probe.ID != nil {
+				id, err := ids.ParseLoadBalancerProbeID(*probe.ID)
+				if err != nil {
+					return nil, err
+				}
+				result["probe_name"] = id.Name
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func expandAzureRmLoadBalancerInlineNatRules(input []interface{}, loadBalancerId ids.LoadBalancerID) *[]network.InboundNatRule {
+	rules := make([]network.InboundNatRule, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		frontendIPConfigurationId := ids.NewLoadBalancerFrontendIPConfigurationID(loadBalancerId.SubscriptionID, loadBalancerId.ResourceGroup, loadBalancerId.Name, v["frontend_ip_configuration_name"].(string))
+		rules = append(rules, network.InboundNatRule{
+			Name: utils.String(v["name"].(string)),
+			InboundNatRulePropertiesFormat: &network.InboundNatRulePropertiesFormat{
+				FrontendIPConfiguration: &network.SubResource{
+					ID: utils.String(frontendIPConfigurationId.String()),
+				},
+				Protocol:         network.TransportProtocol(v["protocol"].(string)),
+				FrontendPort:     utils.Int32(int32(v["frontend_port"].(int))),
+				BackendPort:      utils.Int32(int32(v["backend_port"].(int))),
+				EnableFloatingIP: utils.Bool(v["enable_floating_ip"].(bool)),
+			},
+		})
+	}
+
+	return &rules
+}
+
+func flattenAzureRmLoadBalancerInlineNatRules(input *[]network.InboundNatRule) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results, nil
+	}
+
+	for _, rule := range *input {
+		result := make(map[string]interface{})
+
+		if rule.Name != nil {
+			result["name"] = *rule.Name
+		}
+
+		if rule.ID != nil {
+			result["id"] = *rule.ID
+		}
+
+		if props := rule.InboundNatRulePropertiesFormat; props != nil {
+			result["protocol"] = string(props.Protocol)
+
+			if props.FrontendPort != nil {
+				result["frontend_port"] = int(*props.FrontendPort)
+			}
+
+			if props.BackendPort != nil {
+				result["backend_port"] = int(*props.BackendPort)
+			}
+
+			if props.EnableFloatingIP != nil {
+				result["enable_floating_ip"] = *props.EnableFloatingIP
+			}
+
+			if config := props.FrontendIPConfiguration; config != nil && config.ID != nil {
+				id, err := ids.ParseLoadBalancerFrontendIPConfigurationID(*config.ID)
+				if err != nil {
+					return nil, err
+				}
+				result["frontend_ip_configuration_name"] = id.Name
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func expandAzureRmLoadBalancerInlineOutboundRules(input []interface{}, loadBalancerId ids.LoadBalancerID) *[]network.OutboundRule {
+	rules := make([]network.OutboundRule, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		frontendIPConfigurations := make([]network.SubResource, 0)
+		for _, configName := range v["frontend_ip_configuration_names"].([]interface{}) {
+			configId := ids.NewLoadBalancerFrontendIPConfigurationID(loadBalancerId.SubscriptionID, loadBalancerId.ResourceGroup, loadBalancerId.Name, configName.(string))
+			frontendIPConfigurations = append(frontendIPConfigurations, network.SubResource{
+				ID: utils.String(configId.String()),
+			})
+		}
+
+		poolId := ids.NewLoadBalancerBackendAddressPoolID(loadBalancerId.SubscriptionID, loadBalancerId.ResourceGroup, loadBalancerId.Name, v["backend_address_pool_name"].(string))
+		rules = append(rules, network.OutboundRule{
+			Name: utils.String(v["name"].(string)),
+			OutboundRulePropertiesFormat: &network.OutboundRulePropertiesFormat{
+				FrontendIPConfigurations: &frontendIPConfigurations,
+				BackendAddressPool: &network.SubResource{
+					ID: utils.String(poolId.String()),
+				},
+				Protocol:               network.Protocol1(v["protocol"].(string)),
+				EnableTCPReset:         utils.Bool(v["enable_tcp_reset"].(bool)),
+				AllocatedOutboundPorts: utils.Int32(int32(v["allocated_outbound_ports"].(int))),
+				IdleTimeoutInMinutes:   utils.Int32(int32(v["idle_timeout_in_minutes"].(int))),
+			},
+		})
+	}
+
+	return &rules
+}
+
+func flattenAzureRmLoadBalancerInlineOutboundRules(input *[]network.OutboundRule) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results, nil
+	}
+
+	for _, rule := range *input {
+		result := make(map[string]interface{})
+
+		if rule.Name != nil {
+			result["name"] = *rule.Name
+		}
+
+		if rule.ID != nil {
+			result["id"] = *rule.ID
+		}
+
+		if props := rule.OutboundRulePropertiesFormat; props != nil {
+			result["protocol"] = string(props.Protocol)
+
+			if props.EnableTCPReset != nil {
+				result["enable_tcp_reset"] = *props.EnableTCPReset
+			}
+
+			if props.AllocatedOutboundPorts != nil {
+				result["allocated_outbound_ports"] = int(*props.AllocatedOutboundPorts)
+			}
+
+			if props.IdleTimeoutInMinutes != nil {
+				result["idle_timeout_in_minutes"] = int(*props.IdleTimeoutInMinutes)
+			}
+
+			frontendIPConfigurationNames := make([]interface{}, 0)
+			if configs := props.FrontendIPConfigurations; configs != nil {
+				for _, config := range *configs {
+					if config.ID == nil {
+						continue
+					}
+
+					id, err := ids.ParseLoadBalancerFrontendIPConfigurationID(*config.ID)
+					if err != nil {
+						return nil, err
+					}
+					frontendIPConfigurationNames = append(frontendIPConfigurationNames, id.Name)
+				}
+			}
+			result["frontend_ip_configuration_names"] = frontendIPConfigurationNames
+
+			if pool := props.BackendAddressPool; pool != nil && pool.ID != nil {
+				id, err := ids.ParseLoadBalancerBackendAddressPoolID(*pool.ID)
+				if err != nil {
+					return nil, err
+				}
+				result["backend_address_pool_name"] = id.Name
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
@@ -218,6 +218,92 @@ func TestAccAzureRMLoadBalancer_emptyPrivateIP(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMLoadBalancer_inlineRules(t *testing.T) {
+	var lb network.LoadBalancer
+	resourceName := "azurerm_lb.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLoadBalancer_inlineRules(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLoadBalancerExists(resourceName, &lb),
+					resource.TestCheckResourceAttr(resourceName, "backend_address_pool.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "probe.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.backend_address_pool_name", "backend"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.probe_name", "http"),
+					resource.TestCheckResourceAttr(resourceName, "nat_rule.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "backend_address_pool.0.id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMLoadBalancer_inlineRulesUpdated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLoadBalancerExists(resourceName, &lb),
+					resource.TestCheckResourceAttr(resourceName, "backend_address_pool.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "probe.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.probe_name", "https"),
+					resource.TestCheckResourceAttr(resourceName, "nat_rule.#", "2"),
+				),
+			},
+			{
+				Config: testAccAzureRMLoadBalancer_inlineRulesExplicitZero(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLoadBalancerExists(resourceName, &lb),
+
+					// The configuration for this step assigns each in-line block = []
+					// to state explicitly that none are desired, so the pools, probes
+					// and rules from the previous step should now be removed.
+					resource.TestCheckResourceAttr(resourceName, "backend_address_pool.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "probe.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "nat_rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLoadBalancer_inlineOutboundRule(t *testing.T) {
+	var lb network.LoadBalancer
+	resourceName := "azurerm_lb.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLoadBalancer_inlineOutboundRule(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLoadBalancerExists(resourceName, &lb),
+					resource.TestCheckResourceAttr(resourceName, "outbound_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "outbound_rule.0.frontend_ip_configuration_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "outbound_rule.0.backend_address_pool_name", "backend"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckAzureRMLoadBalancerExists(resourceName string, lb *network.LoadBalancer) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -231,7 +317,7 @@ func testCheckAzureRMLoadBalancerExists(resourceName string, lb *network.LoadBal
 			return fmt.Errorf("Bad: no resource group found in state for loadbalancer: %s", loadBalancerName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).loadBalancerClient
+		client := testAccProvider.Meta().(*ArmClient).network().loadBalancerClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, loadBalancerName, "")
@@ -250,7 +336,7 @@ func testCheckAzureRMLoadBalancerExists(resourceName string, lb *network.LoadBal
 }
 
 func testCheckAzureRMLoadBalancerDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).loadBalancerClient
+	client := testAccProvider.Meta().(*ArmClient).network().loadBalancerClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -489,3 +575,202 @@ resource "azurerm_lb" "test" {
 }
 `, rInt, location, rInt, rInt, rInt)
 }
+
+func testAccAzureRMLoadBalancer_inlineRulesTemplate(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "test-ip-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Static"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMLoadBalancer_inlineRules(rInt int, location string) string {
+	template := testAccAzureRMLoadBalancer_inlineRulesTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lb" "test" {
+  name                = "acctest-loadbalancer-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  frontend_ip_configuration {
+    name                 = "public"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+
+  backend_address_pool {
+    name = "backend"
+  }
+
+  probe {
+    name         = "http"
+    protocol     = "Http"
+    port         = 80
+    request_path = "/"
+  }
+
+  rule {
+    name                           = "http"
+    frontend_ip_configuration_name = "public"
+    backend_address_pool_name      = "backend"
+    probe_name                     = "http"
+    protocol                       = "Tcp"
+    frontend_port                  = 80
+    backend_port                   = 80
+  }
+
+  nat_rule {
+    name                           = "ssh"
+    frontend_ip_configuration_name = "public"
+    protocol                       = "Tcp"
+    frontend_port                  = 2222
+    backend_port                   = 22
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMLoadBalancer_inlineRulesUpdated(rInt int, location string) string {
+	template := testAccAzureRMLoadBalancer_inlineRulesTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lb" "test" {
+  name                = "acctest-loadbalancer-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  frontend_ip_configuration {
+    name                 = "public"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+
+  backend_address_pool {
+    name = "backend"
+  }
+
+  probe {
+    name         = "http"
+    protocol     = "Http"
+    port         = 80
+    request_path = "/"
+  }
+
+  probe {
+    name     = "https"
+    protocol = "Tcp"
+    port     = 443
+  }
+
+  rule {
+    name                           = "http"
+    frontend_ip_configuration_name = "public"
+    backend_address_pool_name      = "backend"
+    probe_name                     = "http"
+    protocol                       = "Tcp"
+    frontend_port                  = 80
+    backend_port                   = 80
+  }
+
+  rule {
+    name                           = "https"
+    frontend_ip_configuration_name = "public"
+    backend_address_pool_name      = "backend"
+    probe_name                     = "https"
+    protocol                       = "Tcp"
+    frontend_port                  = 443
+    backend_port                   = 443
+    idle_timeout_in_minutes        = 10
+    load_distribution              = "SourceIP"
+  }
+
+  nat_rule {
+    name                           = "ssh"
+    frontend_ip_configuration_name = "public"
+    protocol                       = "Tcp"
+    frontend_port                  = 2222
+    backend_port                   = 22
+  }
+
+  nat_rule {
+    name                           = "rdp"
+    frontend_ip_configuration_name = "public"
+    protocol                       = "Tcp"
+    frontend_port                  = 3389
+    backend_port                   = 3389
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMLoadBalancer_inlineRulesExplicitZero(rInt int, location string) string {
+	template := testAccAzureRMLoadBalancer_inlineRulesTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lb" "test" {
+  name                = "acctest-loadbalancer-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  frontend_ip_configuration {
+    name                 = "public"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+
+  backend_address_pool = []
+  probe                = []
+  rule                 = []
+  nat_rule             = []
+}
+`, template, rInt)
+}
+
+func testAccAzureRMLoadBalancer_inlineOutboundRule(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "test-ip-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_lb" "test" {
+  name                = "acctest-loadbalancer-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+
+  frontend_ip_configuration {
+    name                 = "public"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+
+  backend_address_pool {
+    name = "backend"
+  }
+
+  outbound_rule {
+    name                            = "outbound"
+    frontend_ip_configuration_names = ["public"]
+    backend_address_pool_name       = "backend"
+    protocol                        = "All"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
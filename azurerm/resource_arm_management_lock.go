@@ -5,11 +5,13 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -22,6 +24,12 @@ func resourceArmManagementLock() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -57,8 +65,9 @@ func resourceArmManagementLock() *schema.Resource {
 }
 
 func resourceArmManagementLockCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).managementLocksClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).resources().managementLocksClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Management Lock creation.")
 
 	name := d.Get("name").(string)
@@ -105,8 +114,9 @@ func resourceArmManagementLockCreateUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceArmManagementLockRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).managementLocksClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).resources().managementLocksClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureRMLockId(d.Id())
 	if err != nil {
@@ -134,8 +144,9 @@ func resourceArmManagementLockRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmManagementLockDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).managementLocksClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).resources().managementLocksClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureRMLockId(d.Id())
 	if err != nil {
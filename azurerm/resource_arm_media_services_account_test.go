@@ -37,6 +37,36 @@ func TestAccAzureRMMediaServicesAccount_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMMediaServicesAccount_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_media_services_account.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(5)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMediaServicesAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMediaServicesAccount_basic(ri, rs, location),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckAzureRMMediaServicesAccountExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMediaServicesAccount_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_media_services_account"),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMMediaServicesAccount_multipleAccounts(t *testing.T) {
 	resourceName := "azurerm_media_services_account.test"
 	ri := tf.AccRandTimeInt()
@@ -99,7 +129,7 @@ func testCheckAzureRMMediaServicesAccountExists(resourceName string) resource.Te
 			return fmt.Errorf("Bad: no resource group found in state for Media Services Account: '%s'", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).mediaServicesClient
+		conn := testAccProvider.Meta().(*ArmClient).media().mediaServicesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, name)
@@ -116,7 +146,7 @@ func testCheckAzureRMMediaServicesAccountExists(resourceName string) resource.Te
 }
 
 func testCheckAzureRMMediaServicesAccountDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).mediaServicesClient
+	conn := testAccProvider.Meta().(*ArmClient).media().mediaServicesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -159,6 +189,24 @@ resource "azurerm_media_services_account" "test" {
 `, template, rString)
 }
 
+func testAccAzureRMMediaServicesAccount_requiresImport(rInt int, rString, location string) string {
+	template := testAccAzureRMMediaServicesAccount_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_media_services_account" "import" {
+  name                = "${azurerm_media_services_account.test.name}"
+  location            = "${azurerm_media_services_account.test.location}"
+  resource_group_name = "${azurerm_media_services_account.test.resource_group_name}"
+
+  storage_account {
+    id         = "${azurerm_storage_account.first.id}"
+    is_primary = true
+  }
+}
+`, template)
+}
+
 func testAccAzureRMMediaServicesAccount_multipleAccounts(rInt int, rString, location string) string {
 	template := testAccAzureRMMediaServicesAccount_template(rInt, rString, location)
 	return fmt.Sprintf(`
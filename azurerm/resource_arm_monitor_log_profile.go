@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -9,8 +10,9 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -25,6 +27,13 @@ func resourceArmMonitorLogProfile() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -35,12 +44,12 @@ func resourceArmMonitorLogProfile() *schema.Resource {
 			"storage_account_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+				ValidateFunc: ids.ValidateOrEmpty(ids.ValidateStorageAccountID),
 			},
 			"servicebus_rule_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+				ValidateFunc: ids.ValidateOrEmpty(ids.ValidateAnyOf(ids.ValidateEventHubNamespaceAuthorizationRuleID, ids.ValidateServiceBusNamespaceAuthorizationRuleID)),
 			},
 			"locations": {
 				Type:     schema.TypeSet,
@@ -86,8 +95,9 @@ func resourceArmMonitorLogProfile() *schema.Resource {
 }
 
 func resourceArmLogProfileCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).monitorLogProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).monitor().monitorLogProfilesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	if requireResourcesToBeImported && d.IsNewResource() {
@@ -133,7 +143,7 @@ func resourceArmLogProfileCreateUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	// Wait for Log Profile to become available
-	if err := resource.Retry(600*time.Second, retryLogProfilesClientGet(name, meta)); err != nil {
+	if err := resource.Retry(600*time.Second, retryLogProfilesClientGet(ctx, name, meta)); err != nil {
 		return fmt.Errorf("Error waiting for Log Profile %q to become available: %+v", name, err)
 	}
 
@@ -148,8 +158,9 @@ func resourceArmLogProfileCreateUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmLogProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).monitorLogProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).monitor().monitorLogProfilesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name, err := parseLogProfileNameFromID(d.Id())
 	if err != nil {
@@ -185,8 +196,9 @@ func resourceArmLogProfileRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceArmLogProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).monitorLogProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).monitor().monitorLogProfilesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name, err := parseLogProfileNameFromID(d.Id())
 	if err != nil {
@@ -265,12 +277,16 @@ func flattenAzureRmLogProfileRetentionPolicy(input *insights.RetentionPolicy) []
 	return []interface{}{result}
 }
 
-func retryLogProfilesClientGet(name string, meta interface{}) func() *resource.RetryError {
+func retryLogProfilesClientGet(ctx context.Context, name string, meta interface{}) func() *resource.RetryError {
 	return func() *resource.RetryError {
-		client := meta.(*ArmClient).monitorLogProfilesClient
-		ctx := meta.(*ArmClient).StopContext
+		client := meta.(*ArmClient).monitor().monitorLogProfilesClient
 
 		if _, err := client.Get(ctx, name); err != nil {
+			// the timeout for this operation has been reached, so there's no point retrying
+			if ctx.Err() != nil {
+				return resource.NonRetryableError(err)
+			}
+
 			return resource.RetryableError(err)
 		}
 
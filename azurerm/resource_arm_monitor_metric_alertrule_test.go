@@ -169,7 +169,7 @@ func testCheckAzureRMMonitorMetricAlertRuleExists(resourceName string) resource.
 			return fmt.Errorf("Bad: no resource group found in state for Alert Rule: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).monitorAlertRulesClient
+		client := testAccProvider.Meta().(*ArmClient).monitor().monitorAlertRulesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -186,7 +186,7 @@ func testCheckAzureRMMonitorMetricAlertRuleExists(resourceName string) resource.
 }
 
 func testCheckAzureRMMonitorMetricAlertRuleDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).monitorAlertRulesClient
+	client := testAccProvider.Meta().(*ArmClient).monitor().monitorAlertRulesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -3,9 +3,13 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -18,6 +22,12 @@ func resourceArmMySQLConfiguration() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -43,8 +53,9 @@ func resourceArmMySQLConfiguration() *schema.Resource {
 }
 
 func resourceArmMySQLConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).mysqlConfigurationsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).databases().mysqlConfigurationsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM MySQL Configuration creation.")
 
@@ -53,6 +64,22 @@ func resourceArmMySQLConfigurationCreate(d *schema.ResourceData, meta interface{
 	serverName := d.Get("server_name").(string)
 	value := d.Get("value").(string)
 
+	if requireResourcesToBeImported && d.IsNewResource() {
+		// Configurations always exist on the Server - as such one only needs importing when it's been overridden
+		existing, err := client.Get(ctx, resourceGroup, serverName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing MySQL Configuration %q (Server %q / Resource Group %q): %s", name, serverName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" && existing.ConfigurationProperties != nil {
+			if source := existing.ConfigurationProperties.Source; source != nil && strings.EqualFold(*source, "user-override") {
+				return tf.ImportAsExistsError("azurerm_mysql_configuration", *existing.ID)
+			}
+		}
+	}
+
 	properties := mysql.Configuration{
 		ConfigurationProperties: &mysql.ConfigurationProperties{
 			Value: utils.String(value),
@@ -82,8 +109,9 @@ func resourceArmMySQLConfigurationCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceArmMySQLConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).mysqlConfigurationsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).databases().mysqlConfigurationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -113,8 +141,9 @@ func resourceArmMySQLConfigurationRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmMySQLConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).mysqlConfigurationsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).databases().mysqlConfigurationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
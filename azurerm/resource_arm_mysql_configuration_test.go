@@ -41,6 +41,35 @@ func TestAccAzureRMMySQLConfiguration_characterSetServer(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMMySQLConfiguration_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_mysql_configuration.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMySQLConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMySQLConfiguration_characterSetServer(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMySQLConfigurationValue(resourceName, "hebrew"),
+				),
+			},
+			{
+				Config:      testAccAzureRMMySQLConfiguration_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_mysql_configuration"),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMMySQLConfiguration_interactiveTimeout(t *testing.T) {
 	resourceName := "azurerm_mysql_configuration.test"
 	ri := tf.AccRandTimeInt()
@@ -118,7 +147,7 @@ func testCheckAzureRMMySQLConfigurationValue(resourceName string, value string)
 			return fmt.Errorf("Bad: no resource group found in state for MySQL Configuration: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).mysqlConfigurationsClient
+		client := testAccProvider.Meta().(*ArmClient).databases().mysqlConfigurationsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName, name)
@@ -144,7 +173,7 @@ func testCheckAzureRMMySQLConfigurationValueReset(rInt int, configurationName st
 		resourceGroup := fmt.Sprintf("acctestRG-%d", rInt)
 		serverName := fmt.Sprintf("acctestmysqlsvr-%d", rInt)
 
-		client := testAccProvider.Meta().(*ArmClient).mysqlConfigurationsClient
+		client := testAccProvider.Meta().(*ArmClient).databases().mysqlConfigurationsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName, configurationName)
@@ -167,7 +196,7 @@ func testCheckAzureRMMySQLConfigurationValueReset(rInt int, configurationName st
 }
 
 func testCheckAzureRMMySQLConfigurationDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).mysqlConfigurationsClient
+	client := testAccProvider.Meta().(*ArmClient).databases().mysqlConfigurationsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -197,6 +226,20 @@ func testAccAzureRMMySQLConfiguration_characterSetServer(rInt int, location stri
 	return testAccAzureRMMySQLConfiguration_template(rInt, location, "character_set_server", "hebrew")
 }
 
+func testAccAzureRMMySQLConfiguration_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMMySQLConfiguration_characterSetServer(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_configuration" "import" {
+  name                = "${azurerm_mysql_configuration.test.name}"
+  resource_group_name = "${azurerm_mysql_configuration.test.resource_group_name}"
+  server_name         = "${azurerm_mysql_configuration.test.server_name}"
+  value               = "${azurerm_mysql_configuration.test.value}"
+}
+`, template)
+}
+
 func testAccAzureRMMySQLConfiguration_interactiveTimeout(rInt int, location string) string {
 	return testAccAzureRMMySQLConfiguration_template(rInt, location, "interactive_timeout", "30")
 }
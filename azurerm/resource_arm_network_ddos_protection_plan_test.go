@@ -154,7 +154,7 @@ func testAccAzureRMNetworkDDoSProtectionPlan_disappears(t *testing.T) {
 
 func testCheckAzureRMNetworkDDoSProtectionPlanExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*ArmClient).ddosProtectionPlanClient
+		client := testAccProvider.Meta().(*ArmClient).network().ddosProtectionPlanClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		// Ensure we have enough information in state to look up in API
@@ -196,7 +196,7 @@ func testCheckAzureRMNetworkDDoSProtectionPlanDisappears(resourceName string) re
 			return fmt.Errorf("Bad: no resource group found in state for DDoS Protection Plan: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).ddosProtectionPlanClient
+		client := testAccProvider.Meta().(*ArmClient).network().ddosProtectionPlanClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		future, err := client.Delete(ctx, resourceGroup, name)
 		if err != nil {
@@ -212,7 +212,7 @@ func testCheckAzureRMNetworkDDoSProtectionPlanDisappears(resourceName string) re
 }
 
 func testCheckAzureRMNetworkDDoSProtectionPlanDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).ddosProtectionPlanClient
+	client := testAccProvider.Meta().(*ArmClient).network().ddosProtectionPlanClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
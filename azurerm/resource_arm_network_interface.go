@@ -4,13 +4,15 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -28,6 +30,13 @@ func resourceArmNetworkInterface() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -42,7 +51,7 @@ func resourceArmNetworkInterface() *schema.Resource {
 			"network_security_group_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+				ValidateFunc: ids.ValidateOrEmpty(ids.ValidateNetworkSecurityGroupID),
 			},
 
 			"mac_address": {
@@ -56,7 +65,7 @@ func resourceArmNetworkInterface() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: ids.ValidateVirtualMachineID,
 			},
 
 			"ip_configuration": {
@@ -74,7 +83,7 @@ func resourceArmNetworkInterface() *schema.Resource {
 							Type:             schema.TypeString,
 							Optional:         true,
 							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc:     azure.ValidateResourceID,
+							ValidateFunc:     ids.ValidateSubnetID,
 						},
 
 						"private_ip_address": {
@@ -108,7 +117,7 @@ func resourceArmNetworkInterface() *schema.Resource {
 						"public_ip_address_id": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: azure.ValidateResourceIDOrEmpty,
+							ValidateFunc: ids.ValidateOrEmpty(ids.ValidatePublicIPAddressID),
 						},
 
 						"application_gateway_backend_address_pools_ids": {
@@ -118,7 +127,7 @@ func resourceArmNetworkInterface() *schema.Resource {
 							Deprecated: "This field has been deprecated in favour of the `azurerm_network_interface_application_gateway_backend_address_pool_association` resource.",
 							Elem: &schema.Schema{
 								Type:         schema.TypeString,
-								ValidateFunc: azure.ValidateResourceID,
+								ValidateFunc: ids.ValidateApplicationGatewayBackendAddressPoolID,
 							},
 							Set: schema.HashString,
 						},
@@ -130,7 +139,7 @@ func resourceArmNetworkInterface() *schema.Resource {
 							Deprecated: "This field has been deprecated in favour of the `azurerm_network_interface_backend_address_pool_association` resource.",
 							Elem: &schema.Schema{
 								Type:         schema.TypeString,
-								ValidateFunc: azure.ValidateResourceID,
+								ValidateFunc: ids.ValidateLoadBalancerBackendAddressPoolID,
 							},
 							Set: schema.HashString,
 						},
@@ -142,7 +151,7 @@ func resourceArmNetworkInterface() *schema.Resource {
 							Deprecated: "This field has been deprecated in favour of the `azurerm_network_interface_nat_rule_association` resource.",
 							Elem: &schema.Schema{
 								Type:         schema.TypeString,
-								ValidateFunc: azure.ValidateResourceID,
+								ValidateFunc: ids.ValidateLoadBalancerInboundNatRuleID,
 							},
 							Set: schema.HashString,
 						},
@@ -154,7 +163,7 @@ func resourceArmNetworkInterface() *schema.Resource {
 							Deprecated: "This field has been deprecated in favour of the `azurerm_network_interface_application_security_group_association` resource.",
 							Elem: &schema.Schema{
 								Type:         schema.TypeString,
-								ValidateFunc: azure.ValidateResourceID,
+								ValidateFunc: ids.ValidateApplicationSecurityGroupID,
 							},
 							Set: schema.HashString,
 						},
@@ -244,8 +253,9 @@ func resourceArmNetworkInterface() *schema.Resource {
 }
 
 func resourceArmNetworkInterfaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).ifaceClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().ifaceClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Network Interface creation.")
 
@@ -278,6 +288,18 @@ func resourceArmNetworkInterfaceCreateUpdate(d *schema.ResourceData, meta interf
 	azureRMLockByName(name, networkInterfaceResourceName)
 	defer azureRMUnlockByName(name, networkInterfaceResourceName)
 
+	// the TAP Configurations are managed using the `azurerm_network_interface_virtual_network_tap_association` resource
+	if !d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, name, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if props := existing.InterfacePropertiesFormat; props != nil {
+			properties.TapConfigurations = props.TapConfigurations
+		}
+	}
+
 	if v, ok := d.GetOk("network_security_group_id"); ok {
 		nsgId := v.(string)
 		properties.NetworkSecurityGroup = &network.SecurityGroup{
@@ -361,8 +383,9 @@ func resourceArmNetworkInterfaceCreateUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceArmNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).ifaceClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().ifaceClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -451,8 +474,9 @@ func resourceArmNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).ifaceClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().ifaceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -0,0 +1,190 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmNetworkInterfaceVirtualNetworkTapAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNetworkInterfaceVirtualNetworkTapAssociationCreate,
+		Read:   resourceArmNetworkInterfaceVirtualNetworkTapAssociationRead,
+		Delete: resourceArmNetworkInterfaceVirtualNetworkTapAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ids.ValidateNetworkInterfaceID,
+			},
+
+			"virtual_network_tap_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ids.ValidateVirtualNetworkTapID,
+			},
+		},
+	}
+}
+
+func resourceArmNetworkInterfaceVirtualNetworkTapAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().ifaceTapConfigurationsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Network Interface <-> Virtual Network TAP Association creation.")
+
+	name := d.Get("name").(string)
+	networkInterfaceId, err := ids.ParseNetworkInterfaceID(d.Get("network_interface_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := networkInterfaceId.ResourceGroup
+	networkInterfaceName := networkInterfaceId.Name
+
+	azureRMLockByName(networkInterfaceName, networkInterfaceResourceName)
+	defer azureRMUnlockByName(networkInterfaceName, networkInterfaceResourceName)
+
+	if requireResourcesToBeImported {
+		existing, err := client.Get(ctx, resourceGroup, networkInterfaceName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing TAP Configuration %q (Network Interface %q / Resource Group %q): %+v", name, networkInterfaceName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_network_interface_virtual_network_tap_association", *existing.ID)
+		}
+	}
+
+	tapConfiguration := network.InterfaceTapConfiguration{
+		Name: utils.String(name),
+		InterfaceTapConfigurationPropertiesFormat: &network.InterfaceTapConfigurationPropertiesFormat{
+			VirtualNetworkTap: &network.VirtualNetworkTap{
+				ID: utils.String(d.Get("virtual_network_tap_id").(string)),
+			},
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, networkInterfaceName, name, tapConfiguration)
+	if err != nil {
+		return fmt.Errorf("Error creating TAP Configuration %q (Network Interface %q / Resource Group %q): %+v", name, networkInterfaceName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of TAP Configuration %q (Network Interface %q / Resource Group %q): %+v", name, networkInterfaceName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving TAP Configuration %q (Network Interface %q / Resource Group %q): %+v", name, networkInterfaceName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read TAP Configuration %q (Network Interface %q / Resource Group %q) ID", name, networkInterfaceName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmNetworkInterfaceVirtualNetworkTapAssociationRead(d, meta)
+}
+
+func resourceArmNetworkInterfaceVirtualNetworkTapAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().ifaceTapConfigurationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseNetworkInterfaceTapConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	networkInterfaceName := id.NetworkInterfaceName
+	name := id.Name
+
+	resp, err := client.Get(ctx, resourceGroup, networkInterfaceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] TAP Configuration %q (Network Interface %q / Resource Group %q) was not found - removing from state!", name, networkInterfaceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving TAP Configuration %q (Network Interface %q / Resource Group %q): %+v", name, networkInterfaceName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("network_interface_id", ids.NewNetworkInterfaceID(id.SubscriptionID, resourceGroup, networkInterfaceName).String())
+
+	if props := resp.InterfaceTapConfigurationPropertiesFormat; props != nil {
+		virtualNetworkTapId := ""
+		if props.VirtualNetworkTap != nil && props.VirtualNetworkTap.ID != nil {
+			virtualNetworkTapId = *props.VirtualNetworkTap.ID
+		}
+		d.Set("virtual_network_tap_id", virtualNetworkTapId)
+	}
+
+	return nil
+}
+
+func resourceArmNetworkInterfaceVirtualNetworkTapAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().ifaceTapConfigurationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseNetworkInterfaceTapConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	networkInterfaceName := id.NetworkInterfaceName
+	name := id.Name
+
+	azureRMLockByName(networkInterfaceName, networkInterfaceResourceName)
+	defer azureRMUnlockByName(networkInterfaceName, networkInterfaceResourceName)
+
+	future, err := client.Delete(ctx, resourceGroup, networkInterfaceName, name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting TAP Configuration %q (Network Interface %q / Resource Group %q): %+v", name, networkInterfaceName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of TAP Configuration %q (Network Interface %q / Resource Group %q): %+v", name, networkInterfaceName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
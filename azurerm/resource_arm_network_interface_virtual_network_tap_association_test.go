@@ -0,0 +1,158 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMNetworkInterfaceVirtualNetworkTapAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_network_interface_virtual_network_tap_association.test"
+	ri := testAccRandTimeInt(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkInterfaceVirtualNetworkTapAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkInterfaceVirtualNetworkTapAssociation_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkInterfaceVirtualNetworkTapAssociationExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNetworkInterfaceVirtualNetworkTapAssociation_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_network_interface_virtual_network_tap_association.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkInterfaceVirtualNetworkTapAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkInterfaceVirtualNetworkTapAssociation_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkInterfaceVirtualNetworkTapAssociationExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMNetworkInterfaceVirtualNetworkTapAssociation_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_network_interface_virtual_network_tap_association"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNetworkInterfaceVirtualNetworkTapAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		id, err := ids.ParseNetworkInterfaceTapConfigurationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).network().ifaceTapConfigurationsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.NetworkInterfaceName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: TAP Configuration %q (Network Interface %q / Resource Group %q) does not exist", id.Name, id.NetworkInterfaceName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on ifaceTapConfigurationsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMNetworkInterfaceVirtualNetworkTapAssociationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network().ifaceTapConfigurationsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_network_interface_virtual_network_tap_association" {
+			continue
+		}
+
+		id, err := ids.ParseNetworkInterfaceTapConfigurationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.NetworkInterfaceName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("TAP Configuration %q (Network Interface %q / Resource Group %q) still exists", id.Name, id.NetworkInterfaceName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMNetworkInterfaceVirtualNetworkTapAssociation_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualNetworkTap_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestnic-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_network_interface_virtual_network_tap_association" "test" {
+  name                   = "acctesttapconfig-%d"
+  network_interface_id   = "${azurerm_network_interface.test.id}"
+  virtual_network_tap_id = "${azurerm_virtual_network_tap.test.id}"
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMNetworkInterfaceVirtualNetworkTapAssociation_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface_virtual_network_tap_association" "import" {
+  name                   = "${azurerm_network_interface_virtual_network_tap_association.test.name}"
+  network_interface_id   = "${azurerm_network_interface_virtual_network_tap_association.test.network_interface_id}"
+  virtual_network_tap_id = "${azurerm_network_interface_virtual_network_tap_association.test.virtual_network_tap_id}"
+}
+`, testAccAzureRMNetworkInterfaceVirtualNetworkTapAssociation_basic(rInt, location))
+}
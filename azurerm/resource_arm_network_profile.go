@@ -0,0 +1,362 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var networkProfileResourceName = "azurerm_network_profile"
+
+func resourceArmNetworkProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNetworkProfileCreateUpdate,
+		Read:   resourceArmNetworkProfileRead,
+		Update: resourceArmNetworkProfileCreateUpdate,
+		Delete: resourceArmNetworkProfileDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"container_network_interface_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"ip_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"subnet_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: ids.ValidateSubnetID,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"container_network_interface_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmNetworkProfileCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().networkProfilesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Network Profile creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_network_profile", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	configurations := d.Get("container_network_interface_configuration").([]interface{})
+	subnetNamesToLock, virtualNetworkNamesToLock, err := extractArmNetworkProfileSubnetNamesToLock(configurations)
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(name, networkProfileResourceName)
+	defer azureRMUnlockByName(name, networkProfileResourceName)
+
+	azureRMLockMultipleByName(subnetNamesToLock, subnetResourceName)
+	defer azureRMUnlockMultipleByName(subnetNamesToLock, subnetResourceName)
+
+	azureRMLockMultipleByName(virtualNetworkNamesToLock, virtualNetworkResourceName)
+	defer azureRMUnlockMultipleByName(virtualNetworkNamesToLock, virtualNetworkResourceName)
+
+	parameters := network.Profile{
+		Location: utils.String(location),
+		ProfilePropertiesFormat: &network.ProfilePropertiesFormat{
+			ContainerNetworkInterfaceConfigurations: expandArmNetworkProfileContainerNetworkInterfaceConfigurations(configurations),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Network Profile %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmNetworkProfileRead(d, meta)
+}
+
+func resourceArmNetworkProfileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().networkProfilesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseNetworkProfileID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Network Profile %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.ProfilePropertiesFormat; props != nil {
+		if err := d.Set("container_network_interface_configuration", flattenArmNetworkProfileContainerNetworkInterfaceConfigurations(props.ContainerNetworkInterfaceConfigurations)); err != nil {
+			return fmt.Errorf("Error setting `container_network_interface_configuration`: %+v", err)
+		}
+
+		interfaceIds := make([]interface{}, 0)
+		if interfaces := props.ContainerNetworkInterfaces; interfaces != nil {
+			for _, iface := range *interfaces {
+				if iface.ID != nil {
+					interfaceIds = append(interfaceIds, *iface.ID)
+				}
+			}
+		}
+		if err := d.Set("container_network_interface_ids", interfaceIds); err != nil {
+			return fmt.Errorf("Error setting `container_network_interface_ids`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmNetworkProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().networkProfilesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseNetworkProfileID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	existing, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			// deleted outside of Terraform
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	configurations := make([]interface{}, 0)
+	if props := existing.ProfilePropertiesFormat; props != nil {
+		configurations = flattenArmNetworkProfileContainerNetworkInterfaceConfigurations(props.ContainerNetworkInterfaceConfigurations)
+	}
+	subnetNamesToLock, virtualNetworkNamesToLock, err := extractArmNetworkProfileSubnetNamesToLock(configurations)
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(name, networkProfileResourceName)
+	defer azureRMUnlockByName(name, networkProfileResourceName)
+
+	azureRMLockMultipleByName(subnetNamesToLock, subnetResourceName)
+	defer azureRMUnlockMultipleByName(subnetNamesToLock, subnetResourceName)
+
+	azureRMLockMultipleByName(virtualNetworkNamesToLock, virtualNetworkResourceName)
+	defer azureRMUnlockMultipleByName(virtualNetworkNamesToLock, virtualNetworkResourceName)
+
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func extractArmNetworkProfileSubnetNamesToLock(input []interface{}) (*[]string, *[]string, error) {
+	subnetNames := make([]string, 0)
+	virtualNetworkNames := make([]string, 0)
+
+	for _, configuration := range input {
+		v := configuration.(map[string]interface{})
+
+		for _, ipConfiguration := range v["ip_configuration"].([]interface{}) {
+			vv := ipConfiguration.(map[string]interface{})
+
+			subnetId, err := ids.ParseSubnetID(vv["subnet_id"].(string))
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if !sliceContainsValue(subnetNames, subnetId.Name) {
+				subnetNames = append(subnetNames, subnetId.Name)
+			}
+			if !sliceContainsValue(virtualNetworkNames, subnetId.VirtualNetworkName) {
+				virtualNetworkNames = append(virtualNetworkNames, subnetId.VirtualNetworkName)
+			}
+		}
+	}
+
+	return &subnetNames, &virtualNetworkNames, nil
+}
+
+func expandArmNetworkProfileContainerNetworkInterfaceConfigurations(input []interface{}) *[]network.ContainerNetworkInterfaceConfiguration {
+	configurations := make([]network.ContainerNetworkInterfaceConfiguration, 0)
+
+	for _, configuration := range input {
+		v := configuration.(map[string]interface{})
+
+		ipConfigurations := make([]network.IPConfigurationProfile, 0)
+		for _, ipConfiguration := range v["ip_configuration"].([]interface{}) {
+			vv := ipConfiguration.(map[string]interface{})
+
+			ipConfigurations = append(ipConfigurations, network.IPConfigurationProfile{
+				Name: utils.String(vv["name"].(string)),
+				IPConfigurationProfilePropertiesFormat: &network.IPConfigurationProfilePropertiesFormat{
+					Subnet: &network.Subnet{
+						ID: utils.String(vv["subnet_id"].(string)),
+					},
+				},
+			})
+		}
+
+		configurations = append(configurations, network.ContainerNetworkInterfaceConfiguration{
+			Name: utils.String(v["name"].(string)),
+			ContainerNetworkInterfaceConfigurationPropertiesFormat: &network.ContainerNetworkInterfaceConfigurationPropertiesFormat{
+				IPConfigurations: &ipConfigurations,
+			},
+		})
+	}
+
+	return &configurations
+}
+
+func flattenArmNetworkProfileContainerNetworkInterfaceConfigurations(input *[]network.ContainerNetworkInterfaceConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, configuration := range *input {
+		name := ""
+		if configuration.Name != nil {
+			name = *configuration.Name
+		}
+
+		ipConfigurations := make([]interface{}, 0)
+		if props := configuration.ContainerNetworkInterfaceConfigurationPropertiesFormat; props != nil && props.IPConfigurations != nil {
+			for _, ipConfiguration := range *props.IPConfigurations {
+				ipConfigurationName := ""
+				if ipConfiguration.Name != nil {
+					ipConfigurationName = *ipConfiguration.Name
+				}
+
+				subnetId := ""
+				if ipProps := ipConfiguration.IPConfigurationProfilePropertiesFormat; ipProps != nil && ipProps.Subnet != nil && ipProps.Subnet.ID != nil {
+					subnetId = *ipProps.Subnet.ID
+				}
+
+				ipConfigurations = append(ipConfigurations, map[string]interface{}{
+					"name":      ipConfigurationName,
+					"subnet_id": subnetId,
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":             name,
+			"ip_configuration": ipConfigurations,
+		})
+	}
+
+	return results
+}
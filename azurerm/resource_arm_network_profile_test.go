@@ -0,0 +1,282 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMNetworkProfile_basic(t *testing.T) {
+	resourceName := "azurerm_network_profile.test"
+	ri := testAccRandTimeInt(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkProfile_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "container_network_interface_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_network_interface_configuration.0.ip_configuration.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNetworkProfile_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_network_profile.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkProfile_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkProfileExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMNetworkProfile_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_network_profile"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNetworkProfile_withTags(t *testing.T) {
+	resourceName := "azurerm_network_profile.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkProfile_withTags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+					resource.TestCheckResourceAttr(resourceName, "tags.cost_center", "MSFT"),
+				),
+			},
+			{
+				Config: testAccAzureRMNetworkProfile_withUpdatedTags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Staging"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNetworkProfileExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		id, err := ids.ParseNetworkProfileID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).network().networkProfilesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Network Profile %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on networkProfilesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMNetworkProfileDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network().networkProfilesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_network_profile" {
+			continue
+		}
+
+		id, err := ids.ParseNetworkProfileID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Network Profile %q (Resource Group %q) still exists", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMNetworkProfile_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.1.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.1.0.0/24"
+
+  delegation {
+    name = "acctestdelegation"
+
+    service_delegation {
+      name    = "Microsoft.ContainerInstance/containerGroups"
+      actions = ["Microsoft.Network/virtualNetworks/subnets/action"]
+    }
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMNetworkProfile_basic(rInt int, location string) string {
+	template := testAccAzureRMNetworkProfile_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_profile" "test" {
+  name                = "acctestnetprofile-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  container_network_interface_configuration {
+    name = "acctesthellocnic"
+
+    ip_configuration {
+      name      = "testipconfig"
+      subnet_id = "${azurerm_subnet.test.id}"
+    }
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMNetworkProfile_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_profile" "import" {
+  name                = "${azurerm_network_profile.test.name}"
+  location            = "${azurerm_network_profile.test.location}"
+  resource_group_name = "${azurerm_network_profile.test.resource_group_name}"
+
+  container_network_interface_configuration {
+    name = "acctesthellocnic"
+
+    ip_configuration {
+      name      = "testipconfig"
+      subnet_id = "${azurerm_subnet.test.id}"
+    }
+  }
+}
+`, testAccAzureRMNetworkProfile_basic(rInt, location))
+}
+
+func testAccAzureRMNetworkProfile_withTags(rInt int, location string) string {
+	template := testAccAzureRMNetworkProfile_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_profile" "test" {
+  name                = "acctestnetprofile-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  container_network_interface_configuration {
+    name = "acctesthellocnic"
+
+    ip_configuration {
+      name      = "testipconfig"
+      subnet_id = "${azurerm_subnet.test.id}"
+    }
+  }
+
+  tags = {
+    environment = "Production"
+    cost_center = "MSFT"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMNetworkProfile_withUpdatedTags(rInt int, location string) string {
+	template := testAccAzureRMNetworkProfile_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_profile" "test" {
+  name                = "acctestnetprofile-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  container_network_interface_configuration {
+    name = "acctesthellocnic"
+
+    ip_configuration {
+      name      = "testipconfig"
+      subnet_id = "${azurerm_subnet.test.id}"
+    }
+  }
+
+  tags = {
+    environment = "Staging"
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,437 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmNetworkWatcherFlowLog() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNetworkWatcherFlowLogCreateUpdate,
+		Read:   resourceArmNetworkWatcherFlowLogRead,
+		Update: resourceArmNetworkWatcherFlowLogCreateUpdate,
+		Delete: resourceArmNetworkWatcherFlowLogDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_watcher_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"network_security_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ids.ValidateNetworkSecurityGroupID,
+			},
+
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: ids.ValidateStorageAccountID,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+
+			"retention_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 2),
+			},
+
+			"traffic_analytics": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"workspace_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.UUID,
+						},
+
+						"workspace_region": {
+							Type:             schema.TypeString,
+							Required:         true,
+							StateFunc:        azureRMNormalizeLocation,
+							DiffSuppressFunc: azureRMSuppressLocationDiff,
+						},
+
+						"workspace_resource_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: ids.ValidateLogAnalyticsWorkspaceID,
+						},
+
+						"interval_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      60,
+							ValidateFunc: validation.IntInSlice([]int{10, 60}),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmNetworkWatcherFlowLogCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().watcherClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	watcherName := d.Get("network_watcher_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	networkSecurityGroupId := d.Get("network_security_group_id").(string)
+
+	watcher, err := client.Get(ctx, resourceGroup, watcherName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Network Watcher %q (Resource Group %q): %+v", watcherName, resourceGroup, err)
+	}
+	if watcher.ID == nil {
+		return fmt.Errorf("Cannot read Network Watcher %q (Resource Group %q) ID", watcherName, resourceGroup)
+	}
+
+	// flow logs are configured on the Network Watcher per Network Security Group, so there's no ID returned from the API
+	id := fmt.Sprintf("%s|%s", *watcher.ID, networkSecurityGroupId)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := retrieveArmNetworkWatcherFlowLogStatus(ctx, client, resourceGroup, watcherName, networkSecurityGroupId)
+		if err != nil {
+			return fmt.Errorf("Error checking for presence of existing Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupId, watcherName, resourceGroup, err)
+		}
+
+		if props := existing.FlowLogProperties; props != nil && props.Enabled != nil && *props.Enabled {
+			return tf.ImportAsExistsError("azurerm_network_watcher_flow_log", id)
+		}
+	}
+
+	parameters := network.FlowLogInformation{
+		TargetResourceID: utils.String(networkSecurityGroupId),
+		FlowLogProperties: &network.FlowLogProperties{
+			StorageID:       utils.String(d.Get("storage_account_id").(string)),
+			Enabled:         utils.Bool(d.Get("enabled").(bool)),
+			RetentionPolicy: expandArmNetworkWatcherFlowLogRetentionPolicy(d.Get("retention_policy").([]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("version"); ok {
+		parameters.FlowLogProperties.Format = &network.FlowLogFormatParameters{
+			Type:    network.JSON,
+			Version: utils.Int32(int32(v.(int))),
+		}
+	}
+
+	if v, ok := d.GetOk("traffic_analytics"); ok {
+		parameters.FlowAnalyticsConfiguration = expandArmNetworkWatcherFlowLogTrafficAnalytics(v.([]interface{}))
+	}
+
+	future, err := client.SetFlowLogConfiguration(ctx, resourceGroup, watcherName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error configuring Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupId, watcherName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for configuration of Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupId, watcherName, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmNetworkWatcherFlowLogRead(d, meta)
+}
+
+func resourceArmNetworkWatcherFlowLogRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().watcherClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	watcherId, networkSecurityGroupId, err := parseNetworkWatcherFlowLogId(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := watcherId.ResourceGroup
+	watcherName := watcherId.Name
+
+	resp, err := retrieveArmNetworkWatcherFlowLogStatus(ctx, client, resourceGroup, watcherName, networkSecurityGroupId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Network Watcher %q (Resource Group %q) was not found - removing Flow Log from state!", watcherName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupId, watcherName, resourceGroup, err)
+	}
+
+	d.Set("network_watcher_name", watcherName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("network_security_group_id", networkSecurityGroupId)
+
+	if props := resp.FlowLogProperties; props != nil {
+		d.Set("enabled", props.Enabled)
+		d.Set("storage_account_id", props.StorageID)
+
+		version := 0
+		if format := props.Format; format != nil && format.Version != nil {
+			version = int(*format.Version)
+		}
+		d.Set("version", version)
+
+		if err := d.Set("retention_policy", flattenArmNetworkWatcherFlowLogRetentionPolicy(props.RetentionPolicy)); err != nil {
+			return fmt.Errorf("Error setting `retention_policy`: %+v", err)
+		}
+	}
+
+	if err := d.Set("traffic_analytics", flattenArmNetworkWatcherFlowLogTrafficAnalytics(resp.FlowAnalyticsConfiguration)); err != nil {
+		return fmt.Errorf("Error setting `traffic_analytics`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmNetworkWatcherFlowLogDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().watcherClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	watcherId, networkSecurityGroupId, err := parseNetworkWatcherFlowLogId(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := watcherId.ResourceGroup
+	watcherName := watcherId.Name
+
+	existing, err := retrieveArmNetworkWatcherFlowLogStatus(ctx, client, resourceGroup, watcherName, networkSecurityGroupId)
+	if err != nil {
+		// the Network Watcher has been deleted outside of Terraform
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupId, watcherName, resourceGroup, err)
+	}
+
+	// flow logs can't be removed, only disabled - however the Storage Account must still be specified
+	parameters := network.FlowLogInformation{
+		TargetResourceID: utils.String(networkSecurityGroupId),
+		FlowLogProperties: &network.FlowLogProperties{
+			Enabled: utils.Bool(false),
+		},
+	}
+	if props := existing.FlowLogProperties; props != nil {
+		parameters.FlowLogProperties.StorageID = props.StorageID
+	}
+	if analytics := existing.FlowAnalyticsConfiguration; analytics != nil && analytics.NetworkWatcherFlowAnalyticsConfiguration != nil {
+		config := *analytics.NetworkWatcherFlowAnalyticsConfiguration
+		config.Enabled = utils.Bool(false)
+		parameters.FlowAnalyticsConfiguration = &network.TrafficAnalyticsProperties{
+			NetworkWatcherFlowAnalyticsConfiguration: &config,
+		}
+	}
+
+	future, err := client.SetFlowLogConfiguration(ctx, resourceGroup, watcherName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error disabling Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupId, watcherName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Flow Log for Network Security Group %q to be disabled (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupId, watcherName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// parseNetworkWatcherFlowLogId parses the composite ID `{networkWatcherId}|{networkSecurityGroupId}` of a Flow Log
+func parseNetworkWatcherFlowLogId(input string) (*ids.NetworkWatcherID, string, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, "", fmt.Errorf("Expected ID to be in the format {networkWatcherId}|{networkSecurityGroupId} but got %q", input)
+	}
+
+	watcherId, err := ids.ParseNetworkWatcherID(segments[0])
+	if err != nil {
+		return nil, "", fmt.Errorf("Error parsing Network Watcher ID %q: %+v", segments[0], err)
+	}
+
+	if _, err := ids.ParseNetworkSecurityGroupID(segments[1]); err != nil {
+		return nil, "", fmt.Errorf("Error parsing Network Security Group ID %q: %+v", segments[1], err)
+	}
+
+	return watcherId, segments[1], nil
+}
+
+func retrieveArmNetworkWatcherFlowLogStatus(ctx context.Context, client network.WatchersClient, resourceGroup, watcherName, networkSecurityGroupId string) (network.FlowLogInformation, error) {
+	parameters := network.FlowLogStatusParameters{
+		TargetResourceID: utils.String(networkSecurityGroupId),
+	}
+
+	future, err := client.GetFlowLogStatus(ctx, resourceGroup, watcherName, parameters)
+	if err != nil {
+		// the Response is only populated on the Future when the Network Watcher can't be found
+		return network.FlowLogInformation{
+			Response: autorest.Response{Response: future.Response()},
+		}, err
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return network.FlowLogInformation{}, err
+	}
+
+	return future.Result(client)
+}
+
+func expandArmNetworkWatcherFlowLogRetentionPolicy(input []interface{}) *network.RetentionPolicyParameters {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &network.RetentionPolicyParameters{
+		Enabled: utils.Bool(v["enabled"].(bool)),
+		Days:    utils.Int32(int32(v["days"].(int))),
+	}
+}
+
+func flattenArmNetworkWatcherFlowLogRetentionPolicy(input *network.RetentionPolicyParameters) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	enabled := false
+	if input.Enabled != nil {
+		enabled = *input.Enabled
+	}
+
+	days := 0
+	if input.Days != nil {
+		days = int(*input.Days)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled": enabled,
+			"days":    days,
+		},
+	}
+}
+
+func expandArmNetworkWatcherFlowLogTrafficAnalytics(input []interface{}) *network.TrafficAnalyticsProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &network.TrafficAnalyticsProperties{
+		NetworkWatcherFlowAnalyticsConfiguration: &network.TrafficAnalyticsConfigurationProperties{
+			Enabled:                  utils.Bool(v["enabled"].(bool)),
+			WorkspaceID:              utils.String(v["workspace_id"].(string)),
+			WorkspaceRegion:          utils.String(azureRMNormalizeLocation(v["workspace_region"].(string))),
+			WorkspaceResourceID:      utils.String(v["workspace_resource_id"].(string)),
+			TrafficAnalyticsInterval: utils.Int32(int32(v["interval_in_minutes"].(int))),
+		},
+	}
+}
+
+func flattenArmNetworkWatcherFlowLogTrafficAnalytics(input *network.TrafficAnalyticsProperties) []interface{} {
+	if input == nil || input.NetworkWatcherFlowAnalyticsConfiguration == nil {
+		return []interface{}{}
+	}
+
+	config := *input.NetworkWatcherFlowAnalyticsConfiguration
+
+	// the API returns a disabled configuration without a workspace when Traffic Analytics has never been configured
+	if config.WorkspaceResourceID == nil || *config.WorkspaceResourceID == "" {
+		return []interface{}{}
+	}
+
+	enabled := false
+	if config.Enabled != nil {
+		enabled = *config.Enabled
+	}
+
+	workspaceId := ""
+	if config.WorkspaceID != nil {
+		workspaceId = *config.WorkspaceID
+	}
+
+	workspaceRegion := ""
+	if config.WorkspaceRegion != nil {
+		workspaceRegion = azureRMNormalizeLocation(*config.WorkspaceRegion)
+	}
+
+	interval := 60
+	if config.TrafficAnalyticsInterval != nil {
+		interval = int(*config.TrafficAnalyticsInterval)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":               enabled,
+			"workspace_id":          workspaceId,
+			"workspace_region":      workspaceRegion,
+			"workspace_resource_id": *config.WorkspaceResourceID,
+			"interval_in_minutes":   interval,
+		},
+	}
+}
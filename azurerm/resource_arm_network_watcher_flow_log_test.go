@@ -0,0 +1,423 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestParseNetworkWatcherFlowLogId(t *testing.T) {
+	watcherId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkWatchers/watcher1"
+	networkSecurityGroupId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Network/networkSecurityGroups/nsg1"
+
+	testData := []struct {
+		Name        string
+		Input       string
+		Expected    string
+		ExpectError bool
+	}{
+		{
+			Name:        "Empty",
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Name:        "Network Watcher ID",
+			Input:       watcherId,
+			ExpectError: true,
+		},
+		{
+			Name:        "Not a Network Watcher ID",
+			Input:       networkSecurityGroupId + "|" + networkSecurityGroupId,
+			ExpectError: true,
+		},
+		{
+			Name:        "Not a Network Security Group ID",
+			Input:       watcherId + "|" + watcherId,
+			ExpectError: true,
+		},
+		{
+			Name:        "Too Many Segments",
+			Input:       watcherId + "|" + networkSecurityGroupId + "|" + networkSecurityGroupId,
+			ExpectError: true,
+		},
+		{
+			Name:     "Flow Log ID",
+			Input:    watcherId + "|" + networkSecurityGroupId,
+			Expected: networkSecurityGroupId,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		id, nsgId, err := parseNetworkWatcherFlowLogId(v.Input)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Expected no error for %q but got: %+v", v.Name, err)
+		}
+
+		if v.ExpectError {
+			t.Fatalf("Expected an error for %q but didn't get one", v.Name)
+		}
+
+		if id.Name != "watcher1" {
+			t.Fatalf("Expected the Network Watcher Name to be %q but got %q", "watcher1", id.Name)
+		}
+
+		if nsgId != v.Expected {
+			t.Fatalf("Expected the Network Security Group ID to be %q but got %q", v.Expected, nsgId)
+		}
+	}
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_basic(t *testing.T) {
+	resourceName := "azurerm_network_watcher_flow_log.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkWatcherDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_basicConfig(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "retention_policy.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "retention_policy.0.days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_analytics.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_network_watcher_flow_log.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(5)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkWatcherDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_basicConfig(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMNetworkWatcherFlowLog_requiresImportConfig(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_network_watcher_flow_log"),
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_disabled(t *testing.T) {
+	resourceName := "azurerm_network_watcher_flow_log.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(5)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkWatcherDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_basicConfig(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_disabledConfig(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_trafficAnalytics(t *testing.T) {
+	resourceName := "azurerm_network_watcher_flow_log.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(5)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkWatcherDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_basicConfig(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "traffic_analytics.#", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_trafficAnalyticsConfig(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "traffic_analytics.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_analytics.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_analytics.0.interval_in_minutes", "10"),
+					resource.TestCheckResourceAttrSet(resourceName, "traffic_analytics.0.workspace_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "traffic_analytics.0.workspace_resource_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_updateStorageAccount(t *testing.T) {
+	resourceName := "azurerm_network_watcher_flow_log.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(5)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkWatcherDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_basicConfig(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "storage_account_id", "azurerm_storage_account.test", "id"),
+				),
+			},
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_updateStorageAccountConfig(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "storage_account_id", "azurerm_storage_account.test2", "id"),
+					resource.TestCheckResourceAttr(resourceName, "retention_policy.0.days", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNetworkWatcherFlowLogExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		watcherId, networkSecurityGroupId, err := parseNetworkWatcherFlowLogId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).network().watcherClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := retrieveArmNetworkWatcherFlowLogStatus(ctx, client, watcherId.ResourceGroup, watcherId.Name, networkSecurityGroupId)
+		if err != nil {
+			return fmt.Errorf("Bad: Get Flow Log Status on watcherClient: %+v", err)
+		}
+
+		if resp.FlowLogProperties == nil || resp.StorageID == nil {
+			return fmt.Errorf("Bad: Flow Log for Network Security Group %q (Network Watcher %q) has not been configured", networkSecurityGroupId, watcherId.Name)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_network_watcher" "test" {
+  name                = "acctestnw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestnsg-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_kind             = "StorageV2"
+  account_replication_type = "LRS"
+}
+`, rInt, location, rInt, rInt, rString)
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_basicConfig(rInt int, rString string, location string) string {
+	template := testAccAzureRMNetworkWatcherFlowLog_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_watcher_flow_log" "test" {
+  network_watcher_name      = "${azurerm_network_watcher.test.name}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+  storage_account_id        = "${azurerm_storage_account.test.id}"
+  enabled                   = true
+  version                   = 2
+
+  retention_policy {
+    enabled = true
+    days    = 7
+  }
+}
+`, template)
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_requiresImportConfig(rInt int, rString string, location string) string {
+	template := testAccAzureRMNetworkWatcherFlowLog_basicConfig(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_watcher_flow_log" "import" {
+  network_watcher_name      = "${azurerm_network_watcher_flow_log.test.network_watcher_name}"
+  resource_group_name       = "${azurerm_network_watcher_flow_log.test.resource_group_name}"
+  network_security_group_id = "${azurerm_network_watcher_flow_log.test.network_security_group_id}"
+  storage_account_id        = "${azurerm_network_watcher_flow_log.test.storage_account_id}"
+  enabled                   = true
+  version                   = 2
+
+  retention_policy {
+    enabled = true
+    days    = 7
+  }
+}
+`, template)
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_disabledConfig(rInt int, rString string, location string) string {
+	template := testAccAzureRMNetworkWatcherFlowLog_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_watcher_flow_log" "test" {
+  network_watcher_name      = "${azurerm_network_watcher.test.name}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+  storage_account_id        = "${azurerm_storage_account.test.id}"
+  enabled                   = false
+  version                   = 2
+
+  retention_policy {
+    enabled = true
+    days    = 7
+  }
+}
+`, template)
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_trafficAnalyticsConfig(rInt int, rString string, location string) string {
+	template := testAccAzureRMNetworkWatcherFlowLog_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestlaw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_network_watcher_flow_log" "test" {
+  network_watcher_name      = "${azurerm_network_watcher.test.name}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+  storage_account_id        = "${azurerm_storage_account.test.id}"
+  enabled                   = true
+  version                   = 2
+
+  retention_policy {
+    enabled = true
+    days    = 7
+  }
+
+  traffic_analytics {
+    enabled               = true
+    workspace_id          = "${azurerm_log_analytics_workspace.test.workspace_id}"
+    workspace_region      = "${azurerm_log_analytics_workspace.test.location}"
+    workspace_resource_id = "${azurerm_log_analytics_workspace.test.id}"
+    interval_in_minutes   = 10
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_updateStorageAccountConfig(rInt int, rString string, location string) string {
+	template := testAccAzureRMNetworkWatcherFlowLog_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test2" {
+  name                     = "acctestsa2%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_kind             = "StorageV2"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_network_watcher_flow_log" "test" {
+  network_watcher_name      = "${azurerm_network_watcher.test.name}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+  storage_account_id        = "${azurerm_storage_account.test2.id}"
+  enabled                   = true
+  version                   = 2
+
+  retention_policy {
+    enabled = true
+    days    = 30
+  }
+}
+`, template, rString)
+}
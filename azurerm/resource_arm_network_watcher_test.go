@@ -26,6 +26,9 @@ func TestAccAzureRMNetworkWatcher(t *testing.T) {
 		"DataSource": {
 			"basic": testAccDataSourceAzureRMNetworkWatcher_basic,
 		},
+		"DataSourceNextHop": {
+			"basic": testAccDataSourceAzureRMNetworkWatcherNextHop_basic,
+		},
 		"ConnectionMonitorOld": {
 			"addressBasic":              testAccAzureRMConnectionMonitor_addressBasic,
 			"addressComplete":           testAccAzureRMConnectionMonitor_addressComplete,
@@ -57,6 +60,13 @@ func TestAccAzureRMNetworkWatcher(t *testing.T) {
 			"bothDestinationsInvalid":   testAccAzureRMNetworkConnectionMonitor_conflictingDestinations,
 			"requiresImport":            testAccAzureRMNetworkConnectionMonitor_requiresImport,
 		},
+		"FlowLog": {
+			"basic":                testAccAzureRMNetworkWatcherFlowLog_basic,
+			"requiresImport":       testAccAzureRMNetworkWatcherFlowLog_requiresImport,
+			"disabled":             testAccAzureRMNetworkWatcherFlowLog_disabled,
+			"trafficAnalytics":     testAccAzureRMNetworkWatcherFlowLog_trafficAnalytics,
+			"updateStorageAccount": testAccAzureRMNetworkWatcherFlowLog_updateStorageAccount,
+		},
 		"PacketCapture": {
 			"localDisk":                  testAccAzureRMNetworkPacketCapture_localDisk,
 			"storageAccount":             testAccAzureRMNetworkPacketCapture_storageAccount,
@@ -213,7 +223,7 @@ func testCheckAzureRMNetworkWatcherExists(resourceName string) resource.TestChec
 			return fmt.Errorf("Bad: no resource group found in state for Network Watcher: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).watcherClient
+		client := testAccProvider.Meta().(*ArmClient).network().watcherClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -242,7 +252,7 @@ func testCheckAzureRMNetworkWatcherDisappears(resourceName string) resource.Test
 			return fmt.Errorf("Bad: no resource group found in state for Network Watcher: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).watcherClient
+		client := testAccProvider.Meta().(*ArmClient).network().watcherClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		future, err := client.Delete(ctx, resourceGroup, name)
 		if err != nil {
@@ -269,7 +279,7 @@ func testCheckAzureRMNetworkWatcherDestroy(s *terraform.State) error {
 		name := rs.Primary.Attributes["name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).watcherClient
+		client := testAccProvider.Meta().(*ArmClient).network().watcherClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, name)
 
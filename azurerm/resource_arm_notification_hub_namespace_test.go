@@ -69,7 +69,7 @@ func testCheckAzureRMNotificationHubNamespaceExists(resourceName string) resourc
 			return fmt.Errorf("not found: %s", resourceName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).notificationNamespacesClient
+		client := testAccProvider.Meta().(*ArmClient).notificationHubs().notificationNamespacesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
@@ -89,7 +89,7 @@ func testCheckAzureRMNotificationHubNamespaceExists(resourceName string) resourc
 }
 
 func testCheckAzureRMNotificationHubNamespaceDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).notificationNamespacesClient
+	client := testAccProvider.Meta().(*ArmClient).notificationHubs().notificationNamespacesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
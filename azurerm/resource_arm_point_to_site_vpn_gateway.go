@@ -0,0 +1,244 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmPointToSiteVpnGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPointToSiteVpnGatewayCreateUpdate,
+		Read:   resourceArmPointToSiteVpnGatewayRead,
+		Update: resourceArmPointToSiteVpnGatewayCreateUpdate,
+		Delete: resourceArmPointToSiteVpnGatewayDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"virtual_hub_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ids.ValidateVirtualHubID,
+			},
+
+			"vpn_server_configuration_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: ids.ValidatePointToSiteVpnServerConfigurationID,
+			},
+
+			"client_address_pool": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.CIDR,
+				},
+			},
+
+			"scale_unit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmPointToSiteVpnGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().p2sVpnGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Point-to-Site VPN Gateway creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Point-to-Site VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_point_to_site_vpn_gateway", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	addressPrefixes := make([]string, 0)
+	for _, v := range d.Get("client_address_pool").([]interface{}) {
+		addressPrefixes = append(addressPrefixes, v.(string))
+	}
+
+	gateway := network.P2SVpnGateway{
+		Location: utils.String(location),
+		P2SVpnGatewayProperties: &network.P2SVpnGatewayProperties{
+			VirtualHub: &network.SubResource{
+				ID: utils.String(d.Get("virtual_hub_id").(string)),
+			},
+			P2SVpnServerConfiguration: &network.SubResource{
+				ID: utils.String(d.Get("vpn_server_configuration_id").(string)),
+			},
+			VpnClientAddressPool: &network.AddressSpace{
+				AddressPrefixes: &addressPrefixes,
+			},
+			VpnGatewayScaleUnit: utils.Int32(int32(d.Get("scale_unit").(int))),
+		},
+		Tags: expandTags(tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, gateway)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Point-to-Site VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Point-to-Site VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Point-to-Site VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Point-to-Site VPN Gateway %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmPointToSiteVpnGatewayRead(d, meta)
+}
+
+func resourceArmPointToSiteVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().p2sVpnGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParsePointToSiteVpnGatewayID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Point-to-Site VPN Gateway %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Point-to-Site VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.P2SVpnGatewayProperties; props != nil {
+		virtualHubId := ""
+		if props.VirtualHub != nil && props.VirtualHub.ID != nil {
+			virtualHubId = *props.VirtualHub.ID
+		}
+		d.Set("virtual_hub_id", virtualHubId)
+
+		vpnServerConfigurationId := ""
+		if props.P2SVpnServerConfiguration != nil && props.P2SVpnServerConfiguration.ID != nil {
+			vpnServerConfigurationId = *props.P2SVpnServerConfiguration.ID
+		}
+		d.Set("vpn_server_configuration_id", vpnServerConfigurationId)
+
+		clientAddressPool := make([]string, 0)
+		if props.VpnClientAddressPool != nil && props.VpnClientAddressPool.AddressPrefixes != nil {
+			clientAddressPool = *props.VpnClientAddressPool.AddressPrefixes
+		}
+		if err := d.Set("client_address_pool", clientAddressPool); err != nil {
+			return fmt.Errorf("Error setting `client_address_pool`: %+v", err)
+		}
+
+		scaleUnit := 0
+		if props.VpnGatewayScaleUnit != nil {
+			scaleUnit = int(*props.VpnGatewayScaleUnit)
+		}
+		d.Set("scale_unit", scaleUnit)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmPointToSiteVpnGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().p2sVpnGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParsePointToSiteVpnGatewayID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Point-to-Site VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of Point-to-Site VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMPointToSiteVpnGateway_basic(t *testing.T) {
+	resourceName := "azurerm_point_to_site_vpn_gateway.test"
+	ri := testAccRandTimeInt(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPointToSiteVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPointToSiteVpnGateway_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPointToSiteVpnGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale_unit", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPointToSiteVpnGateway_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_point_to_site_vpn_gateway.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPointToSiteVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPointToSiteVpnGateway_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPointToSiteVpnGatewayExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMPointToSiteVpnGateway_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_point_to_site_vpn_gateway"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPointToSiteVpnGateway_update(t *testing.T) {
+	resourceName := "azurerm_point_to_site_vpn_gateway.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPointToSiteVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPointToSiteVpnGateway_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPointToSiteVpnGatewayExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMPointToSiteVpnGateway_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPointToSiteVpnGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale_unit", "2"),
+					resource.TestCheckResourceAttr(resourceName, "client_address_pool.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMPointToSiteVpnGatewayExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).network().p2sVpnGatewaysClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Point-to-Site VPN Gateway %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on p2sVpnGatewaysClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPointToSiteVpnGatewayDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network().p2sVpnGatewaysClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_point_to_site_vpn_gateway" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Point-to-Site VPN Gateway %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMPointToSiteVpnGateway_template(rInt int, location string) string {
+	template := testAccAzureRMPointToSiteVpnServerConfiguration_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctestvhub%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  address_prefix      = "10.0.1.0/24"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMPointToSiteVpnGateway_basic(rInt int, location string) string {
+	template := testAccAzureRMPointToSiteVpnGateway_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_point_to_site_vpn_gateway" "test" {
+  name                        = "acctestp2svpngw%d"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  location                    = "${azurerm_resource_group.test.location}"
+  virtual_hub_id              = "${azurerm_virtual_hub.test.id}"
+  vpn_server_configuration_id = "${azurerm_point_to_site_vpn_server_configuration.test.id}"
+  client_address_pool         = ["10.10.0.0/24"]
+}
+`, template, rInt)
+}
+
+func testAccAzureRMPointToSiteVpnGateway_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_point_to_site_vpn_gateway" "import" {
+  name                        = "${azurerm_point_to_site_vpn_gateway.test.name}"
+  resource_group_name         = "${azurerm_point_to_site_vpn_gateway.test.resource_group_name}"
+  location                    = "${azurerm_point_to_site_vpn_gateway.test.location}"
+  virtual_hub_id              = "${azurerm_point_to_site_vpn_gateway.test.virtual_hub_id}"
+  vpn_server_configuration_id = "${azurerm_point_to_site_vpn_gateway.test.vpn_server_configuration_id}"
+  client_address_pool         = ["10.10.0.0/24"]
+}
+`, testAccAzureRMPointToSiteVpnGateway_basic(rInt, location))
+}
+
+func testAccAzureRMPointToSiteVpnGateway_updated(rInt int, location string) string {
+	template := testAccAzureRMPointToSiteVpnGateway_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_point_to_site_vpn_gateway" "test" {
+  name                        = "acctestp2svpngw%d"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  location                    = "${azurerm_resource_group.test.location}"
+  virtual_hub_id              = "${azurerm_virtual_hub.test.id}"
+  vpn_server_configuration_id = "${azurerm_point_to_site_vpn_server_configuration.test.id}"
+  client_address_pool         = ["10.10.0.0/24", "10.11.0.0/24"]
+  scale_unit                  = 2
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,512 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmPointToSiteVpnServerConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPointToSiteVpnServerConfigurationCreateUpdate,
+		Read:   resourceArmPointToSiteVpnServerConfigurationRead,
+		Update: resourceArmPointToSiteVpnServerConfigurationCreateUpdate,
+		Delete: resourceArmPointToSiteVpnServerConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"virtual_wan_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ids.ValidateVirtualWanID,
+			},
+
+			"vpn_protocols": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.VpnGatewayTunnelingProtocolIkeV2),
+						string(network.VpnGatewayTunnelingProtocolOpenVPN),
+					}, false),
+				},
+			},
+
+			"client_root_certificate": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"public_cert_data": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"client_revoked_certificate": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"thumbprint": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"radius_server": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"secret": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"server_root_certificate": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"public_cert_data": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+								},
+							},
+						},
+
+						"client_root_certificate": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"thumbprint": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"ipsec_policy": ipsecPolicySchema(),
+		},
+	}
+}
+
+func resourceArmPointToSiteVpnServerConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().p2sVpnServerConfigsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Point-to-Site VPN Server Configuration creation.")
+
+	name := d.Get("name").(string)
+	virtualWanId, err := ids.ParseVirtualWanID(d.Get("virtual_wan_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := virtualWanId.ResourceGroup
+	virtualWanName := virtualWanId.Name
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, virtualWanName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Point-to-Site VPN Server Configuration %q (Virtual WAN %q / Resource Group %q): %+v", name, virtualWanName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_point_to_site_vpn_server_configuration", *existing.ID)
+		}
+	}
+
+	vpnProtocols := make([]network.VpnGatewayTunnelingProtocol, 0)
+	for _, v := range d.Get("vpn_protocols").(*schema.Set).List() {
+		vpnProtocols = append(vpnProtocols, network.VpnGatewayTunnelingProtocol(v.(string)))
+	}
+
+	props := network.P2SVpnServerConfigurationProperties{
+		Name:         utils.String(name),
+		VpnProtocols: &vpnProtocols,
+		P2SVpnServerConfigVpnClientRootCertificates:    expandArmPointToSiteVpnServerConfigurationClientRootCertificates(d.Get("client_root_certificate").(*schema.Set).List()),
+		P2SVpnServerConfigVpnClientRevokedCertificates: expandArmPointToSiteVpnServerConfigurationClientRevokedCertificates(d.Get("client_revoked_certificate").(*schema.Set).List()),
+		VpnClientIpsecPolicies:                         expandArmVirtualNetworkGatewayConnectionIpsecPolicies(d.Get("ipsec_policy").([]interface{})),
+	}
+
+	if radiusServers := d.Get("radius_server").([]interface{}); len(radiusServers) > 0 && radiusServers[0] != nil {
+		radiusServer := radiusServers[0].(map[string]interface{})
+		props.RadiusServerAddress = utils.String(radiusServer["address"].(string))
+		props.RadiusServerSecret = utils.String(radiusServer["secret"].(string))
+		props.P2SVpnServerConfigRadiusServerRootCertificates = expandArmPointToSiteVpnServerConfigurationRadiusServerRootCertificates(radiusServer["server_root_certificate"].(*schema.Set).List())
+		props.P2SVpnServerConfigRadiusClientRootCertificates = expandArmPointToSiteVpnServerConfigurationRadiusClientRootCertificates(radiusServer["client_root_certificate"].(*schema.Set).List())
+	}
+
+	parameters := network.P2SVpnServerConfiguration{
+		Name:                                utils.String(name),
+		P2SVpnServerConfigurationProperties: &props,
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, virtualWanName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Point-to-Site VPN Server Configuration %q (Virtual WAN %q / Resource Group %q): %+v", name, virtualWanName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Point-to-Site VPN Server Configuration %q (Virtual WAN %q / Resource Group %q): %+v", name, virtualWanName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, virtualWanName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Point-to-Site VPN Server Configuration %q (Virtual WAN %q / Resource Group %q): %+v", name, virtualWanName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Point-to-Site VPN Server Configuration %q (Virtual WAN %q / Resource Group %q) ID", name, virtualWanName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmPointToSiteVpnServerConfigurationRead(d, meta)
+}
+
+func resourceArmPointToSiteVpnServerConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().p2sVpnServerConfigsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParsePointToSiteVpnServerConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	virtualWanName := id.VirtualWanName
+	name := id.Name
+
+	resp, err := client.Get(ctx, resourceGroup, virtualWanName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Point-to-Site VPN Server Configuration %q (Virtual WAN %q / Resource Group %q) was not found - removing from state!", name, virtualWanName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Point-to-Site VPN Server Configuration %q (Virtual WAN %q / Resource Group %q): %+v", name, virtualWanName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("virtual_wan_id", ids.NewVirtualWanID(id.SubscriptionID, resourceGroup, virtualWanName).String())
+
+	if props := resp.P2SVpnServerConfigurationProperties; props != nil {
+		vpnProtocols := make([]interface{}, 0)
+		if props.VpnProtocols != nil {
+			for _, v := range *props.VpnProtocols {
+				vpnProtocols = append(vpnProtocols, string(v))
+			}
+		}
+		if err := d.Set("vpn_protocols", schema.NewSet(schema.HashString, vpnProtocols)); err != nil {
+			return fmt.Errorf("Error setting `vpn_protocols`: %+v", err)
+		}
+
+		if err := d.Set("client_root_certificate", flattenArmPointToSiteVpnServerConfigurationClientRootCertificates(props.P2SVpnServerConfigVpnClientRootCertificates)); err != nil {
+			return fmt.Errorf("Error setting `client_root_certificate`: %+v", err)
+		}
+
+		if err := d.Set("client_revoked_certificate", flattenArmPointToSiteVpnServerConfigurationClientRevokedCertificates(props.P2SVpnServerConfigVpnClientRevokedCertificates)); err != nil {
+			return fmt.Errorf("Error setting `client_revoked_certificate`: %+v", err)
+		}
+
+		if err := d.Set("radius_server", flattenArmPointToSiteVpnServerConfigurationRadiusServer(d, props)); err != nil {
+			return fmt.Errorf("Error setting `radius_server`: %+v", err)
+		}
+
+		if err := d.Set("ipsec_policy", flattenArmVirtualNetworkGatewayConnectionIpsecPolicies(props.VpnClientIpsecPolicies)); err != nil {
+			return fmt.Errorf("Error setting `ipsec_policy`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmPointToSiteVpnServerConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().p2sVpnServerConfigsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParsePointToSiteVpnServerConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	virtualWanName := id.VirtualWanName
+	name := id.Name
+
+	future, err := client.Delete(ctx, resourceGroup, virtualWanName, name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Point-to-Site VPN Server Configuration %q (Virtual WAN %q / Resource Group %q): %+v", name, virtualWanName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of Point-to-Site VPN Server Configuration %q (Virtual WAN %q / Resource Group %q): %+v", name, virtualWanName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmPointToSiteVpnServerConfigurationClientRootCertificates(input []interface{}) *[]network.P2SVpnServerConfigVpnClientRootCertificate {
+	certificates := make([]network.P2SVpnServerConfigVpnClientRootCertificate, 0)
+
+	for _, v := range input {
+		certificate := v.(map[string]interface{})
+		certificates = append(certificates, network.P2SVpnServerConfigVpnClientRootCertificate{
+			Name: utils.String(certificate["name"].(string)),
+			P2SVpnServerConfigVpnClientRootCertificatePropertiesFormat: &network.P2SVpnServerConfigVpnClientRootCertificatePropertiesFormat{
+				PublicCertData: utils.String(certificate["public_cert_data"].(string)),
+			},
+		})
+	}
+
+	return &certificates
+}
+
+func flattenArmPointToSiteVpnServerConfigurationClientRootCertificates(input *[]network.P2SVpnServerConfigVpnClientRootCertificate) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, certificate := range *input {
+		name := ""
+		if certificate.Name != nil {
+			name = *certificate.Name
+		}
+
+		publicCertData := ""
+		if props := certificate.P2SVpnServerConfigVpnClientRootCertificatePropertiesFormat; props != nil && props.PublicCertData != nil {
+			publicCertData = *props.PublicCertData
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":             name,
+			"public_cert_data": publicCertData,
+		})
+	}
+
+	return results
+}
+
+func expandArmPointToSiteVpnServerConfigurationClientRevokedCertificates(input []interface{}) *[]network.P2SVpnServerConfigVpnClientRevokedCertificate {
+	certificates := make([]network.P2SVpnServerConfigVpnClientRevokedCertificate, 0)
+
+	for _, v := range input {
+		certificate := v.(map[string]interface{})
+		certificates = append(certificates, network.P2SVpnServerConfigVpnClientRevokedCertificate{
+			Name: utils.String(certificate["name"].(string)),
+			P2SVpnServerConfigVpnClientRevokedCertificatePropertiesFormat: &network.P2SVpnServerConfigVpnClientRevokedCertificatePropertiesFormat{
+				Thumbprint: utils.String(certificate["thumbprint"].(string)),
+			},
+		})
+	}
+
+	return &certificates
+}
+
+func flattenArmPointToSiteVpnServerConfigurationClientRevokedCertificates(input *[]network.P2SVpnServerConfigVpnClientRevokedCertificate) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, certificate := range *input {
+		name := ""
+		if certificate.Name != nil {
+			name = *certificate.Name
+		}
+
+		thumbprint := ""
+		if props := certificate.P2SVpnServerConfigVpnClientRevokedCertificatePropertiesFormat; props != nil && props.Thumbprint != nil {
+			thumbprint = *props.Thumbprint
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":       name,
+			"thumbprint": thumbprint,
+		})
+	}
+
+	return results
+}
+
+func expandArmPointToSiteVpnServerConfigurationRadiusServerRootCertificates(input []interface{}) *[]network.P2SVpnServerConfigRadiusServerRootCertificate {
+	certificates := make([]network.P2SVpnServerConfigRadiusServerRootCertificate, 0)
+
+	for _, v := range input {
+		certificate := v.(map[string]interface{})
+		certificates = append(certificates, network.P2SVpnServerConfigRadiusServerRootCertificate{
+			Name: utils.String(certificate["name"].(string)),
+			P2SVpnServerConfigRadiusServerRootCertificatePropertiesFormat: &network.P2SVpnServerConfigRadiusServerRootCertificatePropertiesFormat{
+				PublicCertData: utils.String(certificate["public_cert_data"].(string)),
+			},
+		})
+	}
+
+	return &certificates
+}
+
+func expandArmPointToSiteVpnServerConfigurationRadiusClientRootCertificates(input []interface{}) *[]network.P2SVpnServerConfigRadiusClientRootCertificate {
+	certificates := make([]network.P2SVpnServerConfigRadiusClientRootCertificate, 0)
+
+	for _, v := range input {
+		certificate := v.(map[string]interface{})
+		certificates = append(certificates, network.P2SVpnServerConfigRadiusClientRootCertificate{
+			Name: utils.String(certificate["name"].(string)),
+			P2SVpnServerConfigRadiusClientRootCertificatePropertiesFormat: &network.P2SVpnServerConfigRadiusClientRootCertificatePropertiesFormat{
+				Thumbprint: utils.String(certificate["thumbprint"].(string)),
+			},
+		})
+	}
+
+	return &certificates
+}
+
+func flattenArmPointToSiteVpnServerConfigurationRadiusServer(d *schema.ResourceData, input *network.P2SVpnServerConfigurationProperties) []interface{} {
+	if input.RadiusServerAddress == nil || *input.RadiusServerAddress == "" {
+		return []interface{}{}
+	}
+
+	// the Radius Server Secret isn't returned by the API, so we look it up from the config
+	secret := ""
+	if v, ok := d.GetOk("radius_server.0.secret"); ok {
+		secret = v.(string)
+	}
+
+	serverRootCertificates := make([]interface{}, 0)
+	if input.P2SVpnServerConfigRadiusServerRootCertificates != nil {
+		for _, certificate := range *input.P2SVpnServerConfigRadiusServerRootCertificates {
+			name := ""
+			if certificate.Name != nil {
+				name = *certificate.Name
+			}
+
+			publicCertData := ""
+			if props := certificate.P2SVpnServerConfigRadiusServerRootCertificatePropertiesFormat; props != nil && props.PublicCertData != nil {
+				publicCertData = *props.PublicCertData
+			}
+
+			serverRootCertificates = append(serverRootCertificates, map[string]interface{}{
+				"name":             name,
+				"public_cert_data": publicCertData,
+			})
+		}
+	}
+
+	clientRootCertificates := make([]interface{}, 0)
+	if input.P2SVpnServerConfigRadiusClientRootCertificates != nil {
+		for _, certificate := range *input.P2SVpnServerConfigRadiusClientRootCertificates {
+			name := ""
+			if certificate.Name != nil {
+				name = *certificate.Name
+			}
+
+			thumbprint := ""
+			if props := certificate.P2SVpnServerConfigRadiusClientRootCertificatePropertiesFormat; props != nil && props.Thumbprint != nil {
+				thumbprint = *props.Thumbprint
+			}
+
+			clientRootCertificates = append(clientRootCertificates, map[string]interface{}{
+				"name":       name,
+				"thumbprint": thumbprint,
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"address":                 *input.RadiusServerAddress,
+			"secret":                  secret,
+			"server_root_certificate": serverRootCertificates,
+			"client_root_certificate": clientRootCertificates,
+		},
+	}
+}
@@ -146,7 +146,7 @@ func testCheckAzureRMPolicyAssignmentExists(resourceName string) resource.TestCh
 			return fmt.Errorf("not found: %s", resourceName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).policyAssignmentsClient
+		client := testAccProvider.Meta().(*ArmClient).policy().policyAssignmentsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		id := rs.Primary.ID
@@ -164,7 +164,7 @@ func testCheckAzureRMPolicyAssignmentExists(resourceName string) resource.TestCh
 }
 
 func testCheckAzureRMPolicyAssignmentDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).policyAssignmentsClient
+	client := testAccProvider.Meta().(*ArmClient).policy().policyAssignmentsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
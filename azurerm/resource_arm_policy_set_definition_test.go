@@ -287,7 +287,7 @@ func testCheckAzureRMPolicySetDefinitionExists(resourceName string) resource.Tes
 		policySetName := rs.Primary.Attributes["name"]
 		managementGroupId := rs.Primary.Attributes["management_group_id"]
 
-		client := testAccProvider.Meta().(*ArmClient).policySetDefinitionsClient
+		client := testAccProvider.Meta().(*ArmClient).policy().policySetDefinitionsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		var err error
@@ -311,7 +311,7 @@ func testCheckAzureRMPolicySetDefinitionExists(resourceName string) resource.Tes
 }
 
 func testCheckAzureRMPolicySetDefinitionDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).policySetDefinitionsClient
+	client := testAccProvider.Meta().(*ArmClient).policy().policySetDefinitionsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -41,6 +41,35 @@ func TestAccAzureRMPostgreSQLConfiguration_backslashQuote(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMPostgreSQLConfiguration_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_postgresql_configuration.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPostgreSQLConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPostgreSQLConfiguration_backslashQuote(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPostgreSQLConfigurationValue(resourceName, "on"),
+				),
+			},
+			{
+				Config:      testAccAzureRMPostgreSQLConfiguration_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_postgresql_configuration"),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMPostgreSQLConfiguration_clientMinMessages(t *testing.T) {
 	resourceName := "azurerm_postgresql_configuration.test"
 	ri := tf.AccRandTimeInt()
@@ -124,7 +153,7 @@ func testCheckAzureRMPostgreSQLConfigurationValue(resourceName string, value str
 			return fmt.Errorf("Bad: no resource group found in state for PostgreSQL Configuration: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).postgresqlConfigurationsClient
+		client := testAccProvider.Meta().(*ArmClient).databases().postgresqlConfigurationsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName, name)
@@ -150,7 +179,7 @@ func testCheckAzureRMPostgreSQLConfigurationValueReset(rInt int, configurationNa
 		resourceGroup := fmt.Sprintf("acctestRG-%d", rInt)
 		serverName := fmt.Sprintf("acctestpsqlsvr-%d", rInt)
 
-		client := testAccProvider.Meta().(*ArmClient).postgresqlConfigurationsClient
+		client := testAccProvider.Meta().(*ArmClient).databases().postgresqlConfigurationsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName, configurationName)
@@ -173,7 +202,7 @@ func testCheckAzureRMPostgreSQLConfigurationValueReset(rInt int, configurationNa
 }
 
 func testCheckAzureRMPostgreSQLConfigurationDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).postgresqlConfigurationsClient
+	client := testAccProvider.Meta().(*ArmClient).databases().postgresqlConfigurationsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -203,6 +232,20 @@ func testAccAzureRMPostgreSQLConfiguration_backslashQuote(rInt int, location str
 	return testAccAzureRMPostgreSQLConfiguration_template(rInt, location, "backslash_quote", "on")
 }
 
+func testAccAzureRMPostgreSQLConfiguration_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMPostgreSQLConfiguration_backslashQuote(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_postgresql_configuration" "import" {
+  name                = "${azurerm_postgresql_configuration.test.name}"
+  resource_group_name = "${azurerm_postgresql_configuration.test.resource_group_name}"
+  server_name         = "${azurerm_postgresql_configuration.test.server_name}"
+  value               = "${azurerm_postgresql_configuration.test.value}"
+}
+`, template)
+}
+
 func testAccAzureRMPostgreSQLConfiguration_clientMinMessages(rInt int, location string) string {
 	return testAccAzureRMPostgreSQLConfiguration_template(rInt, location, "client_min_messages", "DEBUG5")
 }
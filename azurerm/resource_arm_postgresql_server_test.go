@@ -340,7 +340,7 @@ func testCheckAzureRMPostgreSQLServerExists(resourceName string) resource.TestCh
 			return fmt.Errorf("Bad: no resource group found in state for PostgreSQL Server: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).postgresqlServersClient
+		client := testAccProvider.Meta().(*ArmClient).databases().postgresqlServersClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -357,7 +357,7 @@ func testCheckAzureRMPostgreSQLServerExists(resourceName string) resource.TestCh
 }
 
 func testCheckAzureRMPostgreSQLServerDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).postgresqlServersClient
+	client := testAccProvider.Meta().(*ArmClient).databases().postgresqlServersClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -4,13 +4,15 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -24,18 +26,20 @@ func resourceArmPublicIp() *schema.Resource {
 
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				id, err := parseAzureResourceID(d.Id())
-				if err != nil {
-					return nil, err
-				}
-				name := id.Path["publicIPAddresses"]
-				if name == "" {
-					return nil, fmt.Errorf("Error parsing supplied resource id. Please check it and rerun:\n %s", d.Id())
+				if _, err := ids.ParsePublicIPAddressID(d.Id()); err != nil {
+					return nil, fmt.Errorf("Error parsing supplied resource id. Please check it and rerun:\n %s: %+v", d.Id(), err)
 				}
 				return []*schema.ResourceData{d}, nil
 			},
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -130,7 +134,31 @@ func resourceArmPublicIp() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: ids.ValidatePublicIPPrefixID,
+			},
+
+			"ddos_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protection_coverage": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.ProtectionCoverageBasic),
+								string(network.ProtectionCoverageStandard),
+							}, false),
+						},
+
+						"ddos_custom_policy_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: ids.ValidateNetworkDDoSCustomPolicyID,
+						},
+					},
+				},
 			},
 
 			"zones": singleZonesSchema(),
@@ -141,8 +169,9 @@ func resourceArmPublicIp() *schema.Resource {
 }
 
 func resourceArmPublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).publicIPClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().publicIPClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Public IP creation.")
 
@@ -176,6 +205,11 @@ func resourceArmPublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	ddosSettings, err := expandArmPublicIpDdosSettings(d.Get("ddos_settings").([]interface{}))
+	if err != nil {
+		return err
+	}
+
 	if requireResourcesToBeImported && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name, "")
 		if err != nil {
@@ -204,6 +238,10 @@ func resourceArmPublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		Zones: zones,
 	}
 
+	if ddosSettings != nil {
+		publicIp.PublicIPAddressPropertiesFormat.DdosSettings = ddosSettings
+	}
+
 	publicIpPrefixId, publicIpPrefixIdOk := d.GetOk("public_ip_prefix_id")
 
 	if publicIpPrefixIdOk {
@@ -252,15 +290,16 @@ func resourceArmPublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).publicIPClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().publicIPClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := ids.ParsePublicIPAddressID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["publicIPAddresses"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, name, "")
 	if err != nil {
@@ -296,6 +335,10 @@ func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
 
 		d.Set("ip_address", props.IPAddress)
 		d.Set("idle_timeout_in_minutes", props.IdleTimeoutInMinutes)
+
+		if err := d.Set("ddos_settings", flattenArmPublicIpDdosSettings(props.DdosSettings)); err != nil {
+			return fmt.Errorf("Error setting `ddos_settings`: %+v", err)
+		}
 	}
 
 	flattenAndSetTags(d, resp.Tags)
@@ -304,15 +347,16 @@ func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceArmPublicIpDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).publicIPClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().publicIPClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := ids.ParsePublicIPAddressID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["publicIPAddresses"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
@@ -325,3 +369,47 @@ func resourceArmPublicIpDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func expandArmPublicIpDdosSettings(input []interface{}) (*network.DdosSettings, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+	protectionCoverage := network.ProtectionCoverage(v["protection_coverage"].(string))
+
+	settings := network.DdosSettings{
+		ProtectionCoverage: protectionCoverage,
+	}
+
+	if policyId := v["ddos_custom_policy_id"].(string); policyId != "" {
+		// only Standard coverage can be customized
+		if protectionCoverage != network.ProtectionCoverageStandard {
+			return nil, fmt.Errorf("`ddos_custom_policy_id` can only be specified when `protection_coverage` is set to `Standard`")
+		}
+
+		settings.DdosCustomPolicy = &network.SubResource{
+			ID: utils.String(policyId),
+		}
+	}
+
+	return &settings, nil
+}
+
+func flattenArmPublicIpDdosSettings(input *network.DdosSettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	policyId := ""
+	if input.DdosCustomPolicy != nil && input.DdosCustomPolicy.ID != nil {
+		policyId = *input.DdosCustomPolicy.ID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"protection_coverage":   string(input.ProtectionCoverage),
+			"ddos_custom_policy_id": policyId,
+		},
+	}
+}
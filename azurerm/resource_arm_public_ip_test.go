@@ -285,6 +285,40 @@ func TestAccAzureRMPublicIpStatic_standard(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMPublicIpStatic_ddosCustomPolicy(t *testing.T) {
+	resourceName := "azurerm_public_ip.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPublicIpDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPublicIPStatic_standard(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPublicIpExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMPublicIPStatic_ddosCustomPolicy(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPublicIpExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ddos_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ddos_settings.0.protection_coverage", "Standard"),
+					resource.TestCheckResourceAttrPair(resourceName, "ddos_settings.0.ddos_custom_policy_id", "azurerm_ddos_custom_policy.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMPublicIpStatic_disappears(t *testing.T) {
 	resourceName := "azurerm_public_ip.test"
 	ri := tf.AccRandTimeInt()
@@ -542,7 +576,7 @@ func testCheckAzureRMPublicIpExists(resourceName string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for public ip: %s", publicIPName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).publicIPClient
+		client := testAccProvider.Meta().(*ArmClient).network().publicIPClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, publicIPName, "")
@@ -572,7 +606,7 @@ func testCheckAzureRMPublicIpDisappears(resourceName string) resource.TestCheckF
 			return fmt.Errorf("Bad: no resource group found in state for public ip: %s", publicIpName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).publicIPClient
+		client := testAccProvider.Meta().(*ArmClient).network().publicIPClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		future, err := client.Delete(ctx, resourceGroup, publicIpName)
 		if err != nil {
@@ -588,7 +622,7 @@ func testCheckAzureRMPublicIpDisappears(resourceName string) resource.TestCheckF
 }
 
 func testCheckAzureRMPublicIpDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).publicIPClient
+	client := testAccProvider.Meta().(*ArmClient).network().publicIPClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -726,6 +760,39 @@ resource "azurerm_public_ip" "test" {
 `, rInt, location, rInt)
 }
 
+func testAccAzureRMPublicIPStatic_ddosCustomPolicy(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_ddos_custom_policy" "test" {
+  name                = "acctestddospolicy-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  protocol_custom_setting {
+    protocol                     = "Tcp"
+    trigger_sensitivity_override = "High"
+  }
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpublicip-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Static"
+  sku                 = "Standard"
+
+  ddos_settings {
+    protection_coverage   = "Standard"
+    ddos_custom_policy_id = "${azurerm_ddos_custom_policy.test.id}"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
 func testAccAzureRMPublicIPStatic_standardPrefix(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -7,12 +7,11 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
 func TestAccAzureRMResourceGroup_basic(t *testing.T) {
 	resourceName := "azurerm_resource_group.test"
-	ri := tf.AccRandTimeInt()
+	ri := testAccRandTimeInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -41,7 +40,7 @@ func TestAccAzureRMResourceGroup_requiresImport(t *testing.T) {
 	}
 
 	resourceName := "azurerm_resource_group.test"
-	ri := tf.AccRandTimeInt()
+	ri := testAccRandTimeInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -64,7 +63,7 @@ func TestAccAzureRMResourceGroup_requiresImport(t *testing.T) {
 
 func TestAccAzureRMResourceGroup_disappears(t *testing.T) {
 	resourceName := "azurerm_resource_group.test"
-	ri := tf.AccRandTimeInt()
+	ri := testAccRandTimeInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -85,7 +84,7 @@ func TestAccAzureRMResourceGroup_disappears(t *testing.T) {
 
 func TestAccAzureRMResourceGroup_withTags(t *testing.T) {
 	resourceName := "azurerm_resource_group.test"
-	ri := tf.AccRandTimeInt()
+	ri := testAccRandTimeInt(t)
 	location := testLocation()
 	preConfig := testAccAzureRMResourceGroup_withTags(ri, location)
 	postConfig := testAccAzureRMResourceGroup_withTagsUpdated(ri, location)
@@ -137,7 +136,7 @@ func testCheckAzureRMResourceGroupExists(resourceName string) resource.TestCheck
 		resourceGroup := rs.Primary.Attributes["name"]
 
 		// Ensure resource group exists in API
-		client := testAccProvider.Meta().(*ArmClient).resourceGroupsClient
+		client := testAccProvider.Meta().(*ArmClient).resources().resourceGroupsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup)
@@ -164,7 +163,7 @@ func testCheckAzureRMResourceGroupDisappears(resourceName string) resource.TestC
 		resourceGroup := rs.Primary.Attributes["name"]
 
 		// Ensure resource group exists in API
-		client := testAccProvider.Meta().(*ArmClient).resourceGroupsClient
+		client := testAccProvider.Meta().(*ArmClient).resources().resourceGroupsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		deleteFuture, err := client.Delete(ctx, resourceGroup)
@@ -182,7 +181,7 @@ func testCheckAzureRMResourceGroupDisappears(resourceName string) resource.TestC
 }
 
 func testCheckAzureRMResourceGroupDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).resourceGroupsClient
+	client := testAccProvider.Meta().(*ArmClient).resources().resourceGroupsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
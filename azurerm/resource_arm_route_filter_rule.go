@@ -0,0 +1,224 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmRouteFilterRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmRouteFilterRuleCreateUpdate,
+		Read:   resourceArmRouteFilterRuleRead,
+		Update: resourceArmRouteFilterRuleCreateUpdate,
+		Delete: resourceArmRouteFilterRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"route_filter_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ids.ValidateRouteFilterID,
+			},
+
+			"access": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(network.Allow),
+				// the API only supports Allow rules at this time
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Allow),
+				}, false),
+			},
+
+			"communities": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmRouteFilterRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().routeFilterRulesClient
+	filtersClient := meta.(*ArmClient).network().routeFiltersClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Route Filter Rule creation.")
+
+	name := d.Get("name").(string)
+	routeFilterId, err := ids.ParseRouteFilterID(d.Get("route_filter_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := routeFilterId.ResourceGroup
+	routeFilterName := routeFilterId.Name
+
+	azureRMLockByName(routeFilterName, routeFilterResourceName)
+	defer azureRMUnlockByName(routeFilterName, routeFilterResourceName)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, routeFilterName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Rule %q (Route Filter %q / Resource Group %q): %+v", name, routeFilterName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_route_filter_rule", *existing.ID)
+		}
+	}
+
+	// Rules must be created in the same location as the Route Filter
+	filter, err := filtersClient.Get(ctx, resourceGroup, routeFilterName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Route Filter %q (Resource Group %q): %+v", routeFilterName, resourceGroup, err)
+	}
+
+	communities := make([]string, 0)
+	for _, v := range d.Get("communities").([]interface{}) {
+		communities = append(communities, v.(string))
+	}
+
+	rule := network.RouteFilterRule{
+		Name:     utils.String(name),
+		Location: filter.Location,
+		RouteFilterRulePropertiesFormat: &network.RouteFilterRulePropertiesFormat{
+			Access:              network.Access(d.Get("access").(string)),
+			RouteFilterRuleType: utils.String("Community"),
+			Communities:         &communities,
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, routeFilterName, name, rule)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Rule %q (Route Filter %q / Resource Group %q): %+v", name, routeFilterName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Rule %q (Route Filter %q / Resource Group %q): %+v", name, routeFilterName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, routeFilterName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Rule %q (Route Filter %q / Resource Group %q): %+v", name, routeFilterName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Rule %q (Route Filter %q / Resource Group %q) ID", name, routeFilterName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmRouteFilterRuleRead(d, meta)
+}
+
+func resourceArmRouteFilterRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().routeFilterRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseRouteFilterRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	routeFilterName := id.RouteFilterName
+	name := id.Name
+
+	resp, err := client.Get(ctx, resourceGroup, routeFilterName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Rule %q (Route Filter %q / Resource Group %q) was not found - removing from state!", name, routeFilterName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Rule %q (Route Filter %q / Resource Group %q): %+v", name, routeFilterName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("route_filter_id", ids.NewRouteFilterID(id.SubscriptionID, resourceGroup, routeFilterName).String())
+
+	if props := resp.RouteFilterRulePropertiesFormat; props != nil {
+		d.Set("access", string(props.Access))
+
+		communities := make([]string, 0)
+		if props.Communities != nil {
+			communities = *props.Communities
+		}
+		if err := d.Set("communities", communities); err != nil {
+			return fmt.Errorf("Error setting `communities`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmRouteFilterRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().routeFilterRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseRouteFilterRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	routeFilterName := id.RouteFilterName
+	name := id.Name
+
+	azureRMLockByName(routeFilterName, routeFilterResourceName)
+	defer azureRMUnlockByName(routeFilterName, routeFilterResourceName)
+
+	future, err := client.Delete(ctx, resourceGroup, routeFilterName, name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Rule %q (Route Filter %q / Resource Group %q): %+v", name, routeFilterName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of Rule %q (Route Filter %q / Resource Group %q): %+v", name, routeFilterName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMRouteFilterRule_basic(t *testing.T) {
+	resourceName := "azurerm_route_filter_rule.test"
+	ri := testAccRandTimeInt(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRouteFilterRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRouteFilterRule_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRouteFilterRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access", "Allow"),
+					resource.TestCheckResourceAttr(resourceName, "communities.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRouteFilterRule_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_route_filter_rule.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRouteFilterRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRouteFilterRule_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRouteFilterRuleExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMRouteFilterRule_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_route_filter_rule"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRouteFilterRule_update(t *testing.T) {
+	resourceName := "azurerm_route_filter_rule.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRouteFilterRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRouteFilterRule_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRouteFilterRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "communities.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMRouteFilterRule_multipleCommunities(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRouteFilterRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "communities.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRouteFilterRuleExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		id, err := ids.ParseRouteFilterRuleID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).network().routeFilterRulesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.RouteFilterName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Rule %q (Route Filter %q / Resource Group %q) does not exist", id.Name, id.RouteFilterName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on routeFilterRulesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMRouteFilterRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network().routeFilterRulesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_route_filter_rule" {
+			continue
+		}
+
+		id, err := ids.ParseRouteFilterRuleID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.RouteFilterName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Rule %q (Route Filter %q / Resource Group %q) still exists", id.Name, id.RouteFilterName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMRouteFilterRule_basic(rInt int, location string) string {
+	template := testAccAzureRMRouteFilter_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_route_filter_rule" "test" {
+  name            = "acctestrfr%d"
+  route_filter_id = "${azurerm_route_filter.test.id}"
+  communities     = ["12076:5010"]
+}
+`, template, rInt)
+}
+
+func testAccAzureRMRouteFilterRule_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_route_filter_rule" "import" {
+  name            = "${azurerm_route_filter_rule.test.name}"
+  route_filter_id = "${azurerm_route_filter_rule.test.route_filter_id}"
+  communities     = ["12076:5010"]
+}
+`, testAccAzureRMRouteFilterRule_basic(rInt, location))
+}
+
+func testAccAzureRMRouteFilterRule_multipleCommunities(rInt int, location string) string {
+	template := testAccAzureRMRouteFilter_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_route_filter_rule" "test" {
+  name            = "acctestrfr%d"
+  route_filter_id = "${azurerm_route_filter.test.id}"
+  access          = "Allow"
+  communities     = ["12076:5010", "12076:5020"]
+}
+`, template, rInt)
+}
@@ -3,10 +3,13 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/v1.0/security"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -25,6 +28,13 @@ func resourceArmSecurityCenterSubscriptionPricing() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"tier": {
 				Type:     schema.TypeString,
@@ -39,13 +49,27 @@ func resourceArmSecurityCenterSubscriptionPricing() *schema.Resource {
 }
 
 func resourceArmSecurityCenterSubscriptionPricingUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).securityCenterPricingClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).securityCenter().securityCenterPricingClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := securityCenterSubscriptionPricingName
 
-	// not doing import check as afaik it always exists (cannot be deleted)
-	// all this resource does is flip a boolean
+	if requireResourcesToBeImported && d.IsNewResource() {
+		// the pricing always exists (and cannot be deleted) - as such it only needs importing once it's been changed from Free
+		existing, err := client.GetSubscriptionPricing(ctx, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Security Center Subscription pricing: %+v", err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" && existing.PricingProperties != nil {
+			if existing.PricingProperties.PricingTier != security.Free {
+				return tf.ImportAsExistsError("azurerm_security_center_subscription_pricing", *existing.ID)
+			}
+		}
+	}
 
 	pricing := security.Pricing{
 		PricingProperties: &security.PricingProperties{
@@ -71,8 +95,9 @@ func resourceArmSecurityCenterSubscriptionPricingUpdate(d *schema.ResourceData,
 }
 
 func resourceArmSecurityCenterSubscriptionPricingRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).securityCenterPricingClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).securityCenter().securityCenterPricingClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resp, err := client.GetSubscriptionPricing(ctx, securityCenterSubscriptionPricingName)
 	if err != nil {
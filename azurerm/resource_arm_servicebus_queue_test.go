@@ -354,7 +354,7 @@ func TestAccAzureRMServiceBusQueue_maxDeliveryCount(t *testing.T) {
 }
 
 func testCheckAzureRMServiceBusQueueDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).serviceBusQueuesClient
+	client := testAccProvider.Meta().(*ArmClient).serviceBus().serviceBusQueuesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -397,7 +397,7 @@ func testCheckAzureRMServiceBusQueueExists(resourceName string) resource.TestChe
 			return fmt.Errorf("Bad: no resource group found in state for queue: %s", queueName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).serviceBusQueuesClient
+		client := testAccProvider.Meta().(*ArmClient).serviceBus().serviceBusQueuesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, namespaceName, queueName)
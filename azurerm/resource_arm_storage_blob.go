@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
@@ -23,6 +25,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 )
 
 func resourceArmStorageBlob() *schema.Resource {
@@ -37,6 +40,13 @@ func resourceArmStorageBlob() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -129,7 +139,8 @@ func resourceArmStorageBlob() *schema.Resource {
 
 func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForCreate(armClient.StopContext, d)
+	defer cancel()
 	env := armClient.environment
 
 	resourceGroupName := d.Get("resource_group_name").(string)
@@ -543,14 +554,15 @@ func resourceArmStorageBlobBlockUploadWorker(ctx resourceArmStorageBlobBlockUplo
 
 func resourceArmStorageBlobUpdate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForUpdate(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageBlobID(d.Id(), armClient.environment)
 	if err != nil {
 		return err
 	}
 
-	resourceGroup, err := determineResourceGroupForStorageAccount(id.storageAccountName, armClient)
+	resourceGroup, err := determineResourceGroupForStorageAccount(ctx, id.storageAccountName, armClient)
 	if err != nil {
 		return err
 	}
@@ -594,14 +606,15 @@ func resourceArmStorageBlobUpdate(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageBlobID(d.Id(), armClient.environment)
 	if err != nil {
 		return err
 	}
 
-	resourceGroup, err := determineResourceGroupForStorageAccount(id.storageAccountName, armClient)
+	resourceGroup, err := determineResourceGroupForStorageAccount(ctx, id.storageAccountName, armClient)
 	if err != nil {
 		return err
 	}
@@ -670,14 +683,15 @@ func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmStorageBlobDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageBlobID(d.Id(), armClient.environment)
 	if err != nil {
 		return err
 	}
 
-	resourceGroup, err := determineResourceGroupForStorageAccount(id.storageAccountName, armClient)
+	resourceGroup, err := determineResourceGroupForStorageAccount(ctx, id.storageAccountName, armClient)
 	if err != nil {
 		return fmt.Errorf("Unable to determine Resource Group for Storage Account %q: %+v", id.storageAccountName, err)
 	}
@@ -737,9 +751,8 @@ func parseStorageBlobID(input string, environment azure.Environment) (*storageBl
 	return &id, nil
 }
 
-func determineResourceGroupForStorageAccount(accountName string, client *ArmClient) (*string, error) {
-	storageClient := client.storageServiceClient
-	ctx := client.StopContext
+func determineResourceGroupForStorageAccount(ctx context.Context, accountName string, client *ArmClient) (*string, error) {
+	storageClient := client.storage().storageServiceClient
 
 	// first locate which resource group the storage account is in
 	groupsResp, err := storageClient.List(ctx)
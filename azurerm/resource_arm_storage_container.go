@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 )
 
 func resourceArmStorageContainer() *schema.Resource {
@@ -27,6 +28,13 @@ func resourceArmStorageContainer() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -90,7 +98,8 @@ func validateArmStorageContainerAccessType(v interface{}, _ string) (warnings []
 
 func resourceArmStorageContainerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(armClient.StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroupName := d.Get("resource_group_name").(string)
@@ -147,14 +156,15 @@ func resourceArmStorageContainerCreateUpdate(d *schema.ResourceData, meta interf
 // read the status of the storage container off Azure.
 func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageContainerID(d.Id(), armClient.environment)
 	if err != nil {
 		return err
 	}
 
-	resourceGroup, err := determineResourceGroupForStorageAccount(id.storageAccountName, armClient)
+	resourceGroup, err := determineResourceGroupForStorageAccount(ctx, id.storageAccountName, armClient)
 	if err != nil {
 		return err
 	}
@@ -235,14 +245,15 @@ func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) e
 // delete a storage container off Azure.
 func resourceArmStorageContainerDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageContainerID(d.Id(), armClient.environment)
 	if err != nil {
 		return err
 	}
 
-	resourceGroup, err := determineResourceGroupForStorageAccount(id.storageAccountName, armClient)
+	resourceGroup, err := determineResourceGroupForStorageAccount(ctx, id.storageAccountName, armClient)
 	if err != nil {
 		return err
 	}
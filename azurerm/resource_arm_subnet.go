@@ -3,11 +3,14 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -23,6 +26,13 @@ func resourceArmSubnet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -44,15 +54,17 @@ func resourceArmSubnet() *schema.Resource {
 			},
 
 			"network_security_group_id": {
-				Type:       schema.TypeString,
-				Optional:   true,
-				Deprecated: "Use the `azurerm_subnet_network_security_group_association` resource instead.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Deprecated:   "Use the `azurerm_subnet_network_security_group_association` resource instead.",
+				ValidateFunc: ids.ValidateOrEmpty(ids.ValidateNetworkSecurityGroupID),
 			},
 
 			"route_table_id": {
-				Type:       schema.TypeString,
-				Optional:   true,
-				Deprecated: "Use the `azurerm_subnet_route_table_association` resource instead.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Deprecated:   "Use the `azurerm_subnet_route_table_association` resource instead.",
+				ValidateFunc: ids.ValidateOrEmpty(ids.ValidateRouteTableID),
 			},
 
 			"ip_configurations": {
@@ -69,6 +81,16 @@ func resourceArmSubnet() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"service_endpoint_policy_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: ids.ValidateSubnetServiceEndpointStoragePolicyID,
+				},
+				Set: schema.HashString,
+			},
+
 			"delegation": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -120,8 +142,9 @@ func resourceArmSubnet() *schema.Resource {
 }
 
 func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).subnetClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().subnetClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Subnet creation.")
 
@@ -188,6 +211,9 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 	serviceEndpoints := expandSubnetServiceEndpoints(d)
 	properties.ServiceEndpoints = &serviceEndpoints
 
+	serviceEndpointPolicies := expandSubnetServiceEndpointPolicies(d)
+	properties.ServiceEndpointPolicies = &serviceEndpointPolicies
+
 	delegations := expandSubnetDelegation(d)
 	properties.Delegations = &delegations
 
@@ -219,16 +245,17 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).subnetClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().subnetClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := ids.ParseSubnetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	vnetName := id.Path["virtualNetworks"]
-	name := id.Path["subnets"]
+	vnetName := id.VirtualNetworkName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, vnetName, name, "")
 
@@ -269,6 +296,11 @@ func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 
+		serviceEndpointPolicyIds := flattenSubnetServiceEndpointPolicies(props.ServiceEndpointPolicies)
+		if err := d.Set("service_endpoint_policy_ids", serviceEndpointPolicyIds); err != nil {
+			return fmt.Errorf("Error setting `service_endpoint_policy_ids`: %+v", err)
+		}
+
 		delegation := flattenSubnetDelegation(props.Delegations)
 		if err := d.Set("delegation", delegation); err != nil {
 			return fmt.Errorf("Error flattening `delegation`: %+v", err)
@@ -279,16 +311,17 @@ func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).subnetClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().subnetClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := ids.ParseSubnetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["subnets"]
-	vnetName := id.Path["virtualNetworks"]
+	name := id.Name
+	vnetName := id.VirtualNetworkName
 
 	if v, ok := d.GetOk("network_security_group_id"); ok {
 		networkSecurityGroupId := v.(string)
@@ -362,6 +395,33 @@ func flattenSubnetServiceEndpoints(serviceEndpoints *[]network.ServiceEndpointPr
 	return endpoints
 }
 
+func expandSubnetServiceEndpointPolicies(d *schema.ResourceData) []network.ServiceEndpointPolicy {
+	policyIds := d.Get("service_endpoint_policy_ids").(*schema.Set).List()
+	policies := make([]network.ServiceEndpointPolicy, 0)
+
+	for _, policyId := range policyIds {
+		policies = append(policies, network.ServiceEndpointPolicy{
+			ID: utils.String(policyId.(string)),
+		})
+	}
+
+	return policies
+}
+
+func flattenSubnetServiceEndpointPolicies(policies *[]network.ServiceEndpointPolicy) []interface{} {
+	policyIds := make([]interface{}, 0)
+
+	if policies != nil {
+		for _, policy := range *policies {
+			if policy.ID != nil {
+				policyIds = append(policyIds, *policy.ID)
+			}
+		}
+	}
+
+	return policyIds
+}
+
 func flattenSubnetIPConfigurations(ipConfigurations *[]network.IPConfiguration) []string {
 	ips := make([]string, 0)
 
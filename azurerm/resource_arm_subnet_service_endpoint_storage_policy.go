@@ -0,0 +1,275 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSubnetServiceEndpointStoragePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSubnetServiceEndpointStoragePolicyCreateUpdate,
+		Read:   resourceArmSubnetServiceEndpointStoragePolicyRead,
+		Update: resourceArmSubnetServiceEndpointStoragePolicyCreateUpdate,
+		Delete: resourceArmSubnetServiceEndpointStoragePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"definition": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"service_resources": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+							Set: schema.HashString,
+						},
+
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 140),
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmSubnetServiceEndpointStoragePolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().serviceEndpointPoliciesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Subnet Service Endpoint Storage Policy creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Subnet Service Endpoint Storage Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_subnet_service_endpoint_storage_policy", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	policy := network.ServiceEndpointPolicy{
+		Location: utils.String(location),
+		ServiceEndpointPolicyPropertiesFormat: &network.ServiceEndpointPolicyPropertiesFormat{
+			ServiceEndpointPolicyDefinitions: expandArmServiceEndpointStoragePolicyDefinitions(d.Get("definition").([]interface{})),
+		},
+		Tags: expandTags(tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, policy)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Subnet Service Endpoint Storage Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Subnet Service Endpoint Storage Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Subnet Service Endpoint Storage Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Subnet Service Endpoint Storage Policy %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSubnetServiceEndpointStoragePolicyRead(d, meta)
+}
+
+func resourceArmSubnetServiceEndpointStoragePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().serviceEndpointPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseSubnetServiceEndpointStoragePolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Subnet Service Endpoint Storage Policy %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Subnet Service Endpoint Storage Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.ServiceEndpointPolicyPropertiesFormat; props != nil {
+		if err := d.Set("definition", flattenArmServiceEndpointStoragePolicyDefinitions(props.ServiceEndpointPolicyDefinitions)); err != nil {
+			return fmt.Errorf("Error setting `definition`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmSubnetServiceEndpointStoragePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().serviceEndpointPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseSubnetServiceEndpointStoragePolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Subnet Service Endpoint Storage Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of Subnet Service Endpoint Storage Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmServiceEndpointStoragePolicyDefinitions(input []interface{}) *[]network.ServiceEndpointPolicyDefinition {
+	definitions := make([]network.ServiceEndpointPolicyDefinition, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		serviceResources := make([]string, 0)
+		for _, resource := range v["service_resources"].(*schema.Set).List() {
+			serviceResources = append(serviceResources, resource.(string))
+		}
+
+		definition := network.ServiceEndpointPolicyDefinition{
+			Name: utils.String(v["name"].(string)),
+			ServiceEndpointPolicyDefinitionPropertiesFormat: &network.ServiceEndpointPolicyDefinitionPropertiesFormat{
+				// only Storage is supported by Service Endpoint Policies at this time
+				Service:          utils.String("Microsoft.Storage"),
+				ServiceResources: &serviceResources,
+			},
+		}
+
+		if description := v["description"].(string); description != "" {
+			definition.ServiceEndpointPolicyDefinitionPropertiesFormat.Description = utils.String(description)
+		}
+
+		definitions = append(definitions, definition)
+	}
+
+	return &definitions
+}
+
+func flattenArmServiceEndpointStoragePolicyDefinitions(input *[]network.ServiceEndpointPolicyDefinition) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, definition := range *input {
+		name := ""
+		if definition.Name != nil {
+			name = *definition.Name
+		}
+
+		description := ""
+		serviceResources := make([]interface{}, 0)
+		if props := definition.ServiceEndpointPolicyDefinitionPropertiesFormat; props != nil {
+			if props.Description != nil {
+				description = *props.Description
+			}
+
+			if props.ServiceResources != nil {
+				for _, resource := range *props.ServiceResources {
+					serviceResources = append(serviceResources, resource)
+				}
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":              name,
+			"description":       description,
+			"service_resources": schema.NewSet(schema.HashString, serviceResources),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,204 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSubnetServiceEndpointStoragePolicy_basic(t *testing.T) {
+	resourceName := "azurerm_subnet_service_endpoint_storage_policy.test"
+	ri := testAccRandTimeInt(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubnetServiceEndpointStoragePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnetServiceEndpointStoragePolicy_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetServiceEndpointStoragePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSubnetServiceEndpointStoragePolicy_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_subnet_service_endpoint_storage_policy.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubnetServiceEndpointStoragePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnetServiceEndpointStoragePolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetServiceEndpointStoragePolicyExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMSubnetServiceEndpointStoragePolicy_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_subnet_service_endpoint_storage_policy"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSubnetServiceEndpointStoragePolicy_update(t *testing.T) {
+	resourceName := "azurerm_subnet_service_endpoint_storage_policy.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubnetServiceEndpointStoragePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnetServiceEndpointStoragePolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetServiceEndpointStoragePolicyExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMSubnetServiceEndpointStoragePolicy_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetServiceEndpointStoragePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.name", "resourcegroup"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.service_resources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSubnetServiceEndpointStoragePolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).network().serviceEndpointPoliciesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Subnet Service Endpoint Storage Policy %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on serviceEndpointPoliciesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSubnetServiceEndpointStoragePolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network().serviceEndpointPoliciesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_subnet_service_endpoint_storage_policy" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Subnet Service Endpoint Storage Policy %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMSubnetServiceEndpointStoragePolicy_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_subnet_service_endpoint_storage_policy" "test" {
+  name                = "acctestsep%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMSubnetServiceEndpointStoragePolicy_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet_service_endpoint_storage_policy" "import" {
+  name                = "${azurerm_subnet_service_endpoint_storage_policy.test.name}"
+  resource_group_name = "${azurerm_subnet_service_endpoint_storage_policy.test.resource_group_name}"
+  location            = "${azurerm_subnet_service_endpoint_storage_policy.test.location}"
+}
+`, testAccAzureRMSubnetServiceEndpointStoragePolicy_basic(rInt, location))
+}
+
+func testAccAzureRMSubnetServiceEndpointStoragePolicy_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_subnet_service_endpoint_storage_policy" "test" {
+  name                = "acctestsep%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  definition {
+    name              = "resourcegroup"
+    description       = "Storage Accounts within the Resource Group"
+    service_resources = ["${azurerm_resource_group.test.id}"]
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, rInt, location, rInt)
+}
@@ -301,6 +301,31 @@ func TestAccAzureRMSubnet_serviceEndpointsVNetUpdate(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSubnet_serviceEndpointPolicy(t *testing.T) {
+	resourceName := "azurerm_subnet.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnet_serviceEndpointPolicy(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "service_endpoint_policy_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckAzureRMSubnetExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -318,7 +343,7 @@ func testCheckAzureRMSubnetExists(resourceName string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for subnet: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).subnetClient
+		client := testAccProvider.Meta().(*ArmClient).network().subnetClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, vnetName, name, "")
@@ -351,8 +376,8 @@ func testCheckAzureRMSubnetRouteTableExists(resourceName string, routeTableId st
 			return fmt.Errorf("Bad: no resource group found in state for subnet: %s", subnetName)
 		}
 
-		networksClient := testAccProvider.Meta().(*ArmClient).vnetClient
-		subnetsClient := testAccProvider.Meta().(*ArmClient).subnetClient
+		networksClient := testAccProvider.Meta().(*ArmClient).network().vnetClient
+		subnetsClient := testAccProvider.Meta().(*ArmClient).network().subnetClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		vnetResp, vnetErr := networksClient.Get(ctx, resourceGroup, vnetName, "")
@@ -400,7 +425,7 @@ func testCheckAzureRMSubnetDisappears(resourceName string) resource.TestCheckFun
 			return fmt.Errorf("Bad: no resource group found in state for subnet: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).subnetClient
+		client := testAccProvider.Meta().(*ArmClient).network().subnetClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		future, err := client.Delete(ctx, resourceGroup, vnetName, name)
 		if err != nil {
@@ -418,7 +443,7 @@ func testCheckAzureRMSubnetDisappears(resourceName string) resource.TestCheckFun
 }
 
 func testCheckAzureRMSubnetDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).subnetClient
+	client := testAccProvider.Meta().(*ArmClient).network().subnetClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -881,3 +906,26 @@ resource "azurerm_subnet" "test" {
 }
 `, rInt, location, rInt, rInt)
 }
+
+func testAccAzureRMSubnet_serviceEndpointPolicy(rInt int, location string) string {
+	template := testAccAzureRMSubnetServiceEndpointStoragePolicy_complete(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                        = "acctestsubnet%d"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  virtual_network_name        = "${azurerm_virtual_network.test.name}"
+  address_prefix              = "10.0.2.0/24"
+  service_endpoints           = ["Microsoft.Storage"]
+  service_endpoint_policy_ids = ["${azurerm_subnet_service_endpoint_storage_policy.test.id}"]
+}
+`, template, rInt, rInt)
+}
@@ -0,0 +1,293 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var virtualHubResourceName = "azurerm_virtual_hub"
+
+func resourceArmVirtualHub() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualHubCreateUpdate,
+		Read:   resourceArmVirtualHubRead,
+		Update: resourceArmVirtualHubCreateUpdate,
+		Delete: resourceArmVirtualHubDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"virtual_wan_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ids.ValidateVirtualWanID,
+			},
+
+			"address_prefix": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CIDR,
+			},
+
+			"route": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address_prefixes": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.CIDR,
+							},
+						},
+
+						"next_hop_ip_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.IPv4Address,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmVirtualHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().virtualHubsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Virtual Hub creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	azureRMLockByName(name, virtualHubResourceName)
+	defer azureRMUnlockByName(name, virtualHubResourceName)
+
+	existing, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_virtual_hub", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	hub := network.VirtualHub{
+		Location: utils.String(location),
+		VirtualHubProperties: &network.VirtualHubProperties{
+			AddressPrefix: utils.String(d.Get("address_prefix").(string)),
+			VirtualWan: &network.SubResource{
+				ID: utils.String(d.Get("virtual_wan_id").(string)),
+			},
+			RouteTable: expandArmVirtualHubRoutes(d.Get("route").([]interface{})),
+		},
+		Tags: expandTags(tags),
+	}
+
+	// the Virtual Network Connections are managed using the `azurerm_virtual_hub_connection` resource
+	if props := existing.VirtualHubProperties; props != nil {
+		hub.VirtualHubProperties.VirtualNetworkConnections = props.VirtualNetworkConnections
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, hub)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Virtual Hub %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualHubRead(d, meta)
+}
+
+func resourceArmVirtualHubRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().virtualHubsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseVirtualHubID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Virtual Hub %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.VirtualHubProperties; props != nil {
+		d.Set("address_prefix", props.AddressPrefix)
+
+		virtualWanId := ""
+		if props.VirtualWan != nil && props.VirtualWan.ID != nil {
+			virtualWanId = *props.VirtualWan.ID
+		}
+		d.Set("virtual_wan_id", virtualWanId)
+
+		if err := d.Set("route", flattenArmVirtualHubRoutes(props.RouteTable)); err != nil {
+			return fmt.Errorf("Error setting `route`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmVirtualHubDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().virtualHubsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseVirtualHubID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	azureRMLockByName(name, virtualHubResourceName)
+	defer azureRMUnlockByName(name, virtualHubResourceName)
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmVirtualHubRoutes(input []interface{}) *network.VirtualHubRouteTable {
+	routes := make([]network.VirtualHubRoute, 0)
+
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+
+		route := v.(map[string]interface{})
+		addressPrefixes := make([]string, 0)
+		for _, prefix := range route["address_prefixes"].([]interface{}) {
+			addressPrefixes = append(addressPrefixes, prefix.(string))
+		}
+
+		routes = append(routes, network.VirtualHubRoute{
+			AddressPrefixes:  &addressPrefixes,
+			NextHopIPAddress: utils.String(route["next_hop_ip_address"].(string)),
+		})
+	}
+
+	return &network.VirtualHubRouteTable{
+		Routes: &routes,
+	}
+}
+
+func flattenArmVirtualHubRoutes(input *network.VirtualHubRouteTable) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.Routes == nil {
+		return results
+	}
+
+	for _, route := range *input.Routes {
+		addressPrefixes := make([]interface{}, 0)
+		if route.AddressPrefixes != nil {
+			for _, prefix := range *route.AddressPrefixes {
+				addressPrefixes = append(addressPrefixes, prefix)
+			}
+		}
+
+		nextHopIpAddress := ""
+		if route.NextHopIPAddress != nil {
+			nextHopIpAddress = *route.NextHopIPAddress
+		}
+
+		results = append(results, map[string]interface{}{
+			"address_prefixes":    addressPrefixes,
+			"next_hop_ip_address": nextHopIpAddress,
+		})
+	}
+
+	return results
+}
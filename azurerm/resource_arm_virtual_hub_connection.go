@@ -0,0 +1,254 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the Virtual Network Connections within a Virtual Hub can only be managed through the Virtual Hub in this
+// version of the API, as such each Connection is added to (or removed from) the Virtual Hub whilst it's locked
+func resourceArmVirtualHubConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualHubConnectionCreateUpdate,
+		Read:   resourceArmVirtualHubConnectionRead,
+		Update: resourceArmVirtualHubConnectionCreateUpdate,
+		Delete: resourceArmVirtualHubConnectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"virtual_hub_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ids.ValidateVirtualHubID,
+			},
+
+			"remote_virtual_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ids.ValidateVirtualNetworkID,
+			},
+
+			"allow_hub_to_remote_vnet_transit": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"allow_remote_vnet_to_use_hub_vnet_gateways": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"enable_internet_security": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualHubConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().virtualHubsClient
+	connectionsClient := meta.(*ArmClient).network().hubVnetConnectionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Virtual Hub Connection creation.")
+
+	name := d.Get("name").(string)
+	virtualHubId, err := ids.ParseVirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := virtualHubId.ResourceGroup
+	virtualHubName := virtualHubId.Name
+
+	azureRMLockByName(virtualHubName, virtualHubResourceName)
+	defer azureRMUnlockByName(virtualHubName, virtualHubResourceName)
+
+	hub, err := client.Get(ctx, resourceGroup, virtualHubName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", virtualHubName, resourceGroup, err)
+	}
+	if hub.VirtualHubProperties == nil {
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): `properties` was nil", virtualHubName, resourceGroup)
+	}
+
+	connections := make([]network.HubVirtualNetworkConnection, 0)
+	if existing := hub.VirtualHubProperties.VirtualNetworkConnections; existing != nil {
+		for _, connection := range *existing {
+			if connection.Name != nil && strings.EqualFold(*connection.Name, name) {
+				if requireResourcesToBeImported && d.IsNewResource() && connection.ID != nil {
+					return tf.ImportAsExistsError("azurerm_virtual_hub_connection", *connection.ID)
+				}
+
+				continue
+			}
+
+			connections = append(connections, connection)
+		}
+	}
+
+	connections = append(connections, network.HubVirtualNetworkConnection{
+		Name: utils.String(name),
+		HubVirtualNetworkConnectionProperties: &network.HubVirtualNetworkConnectionProperties{
+			RemoteVirtualNetwork: &network.SubResource{
+				ID: utils.String(d.Get("remote_virtual_network_id").(string)),
+			},
+			AllowHubToRemoteVnetTransit:         utils.Bool(d.Get("allow_hub_to_remote_vnet_transit").(bool)),
+			AllowRemoteVnetToUseHubVnetGateways: utils.Bool(d.Get("allow_remote_vnet_to_use_hub_vnet_gateways").(bool)),
+			EnableInternetSecurity:              utils.Bool(d.Get("enable_internet_security").(bool)),
+		},
+	})
+	hub.VirtualHubProperties.VirtualNetworkConnections = &connections
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, virtualHubName, hub)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Connection %q to Virtual Hub %q (Resource Group %q): %+v", name, virtualHubName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Connection %q to Virtual Hub %q (Resource Group %q): %+v", name, virtualHubName, resourceGroup, err)
+	}
+
+	read, err := connectionsClient.Get(ctx, resourceGroup, virtualHubName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Connection %q to Virtual Hub %q (Resource Group %q): %+v", name, virtualHubName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Connection %q to Virtual Hub %q (Resource Group %q) ID", name, virtualHubName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualHubConnectionRead(d, meta)
+}
+
+func resourceArmVirtualHubConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().hubVnetConnectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseVirtualHubConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	virtualHubName := id.VirtualHubName
+	name := id.Name
+
+	resp, err := client.Get(ctx, resourceGroup, virtualHubName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Connection %q to Virtual Hub %q was not found in Resource Group %q - removing from state!", name, virtualHubName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Connection %q to Virtual Hub %q (Resource Group %q): %+v", name, virtualHubName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("virtual_hub_id", ids.NewVirtualHubID(id.SubscriptionID, resourceGroup, virtualHubName).String())
+
+	if props := resp.HubVirtualNetworkConnectionProperties; props != nil {
+		remoteVirtualNetworkId := ""
+		if props.RemoteVirtualNetwork != nil && props.RemoteVirtualNetwork.ID != nil {
+			remoteVirtualNetworkId = *props.RemoteVirtualNetwork.ID
+		}
+		d.Set("remote_virtual_network_id", remoteVirtualNetworkId)
+		d.Set("allow_hub_to_remote_vnet_transit", props.AllowHubToRemoteVnetTransit)
+		d.Set("allow_remote_vnet_to_use_hub_vnet_gateways", props.AllowRemoteVnetToUseHubVnetGateways)
+		d.Set("enable_internet_security", props.EnableInternetSecurity)
+	}
+
+	return nil
+}
+
+func resourceArmVirtualHubConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().virtualHubsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseVirtualHubConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	virtualHubName := id.VirtualHubName
+	name := id.Name
+
+	azureRMLockByName(virtualHubName, virtualHubResourceName)
+	defer azureRMUnlockByName(virtualHubName, virtualHubResourceName)
+
+	hub, err := client.Get(ctx, resourceGroup, virtualHubName)
+	if err != nil {
+		// the Virtual Hub (and as such the Connection) has been deleted outside of Terraform
+		if utils.ResponseWasNotFound(hub.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", virtualHubName, resourceGroup, err)
+	}
+	if hub.VirtualHubProperties == nil || hub.VirtualHubProperties.VirtualNetworkConnections == nil {
+		return nil
+	}
+
+	found := false
+	connections := make([]network.HubVirtualNetworkConnection, 0)
+	for _, connection := range *hub.VirtualHubProperties.VirtualNetworkConnections {
+		if connection.Name != nil && strings.EqualFold(*connection.Name, name) {
+			found = true
+			continue
+		}
+
+		connections = append(connections, connection)
+	}
+
+	if !found {
+		return nil
+	}
+
+	hub.VirtualHubProperties.VirtualNetworkConnections = &connections
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, virtualHubName, hub)
+	if err != nil {
+		return fmt.Errorf("Error removing Connection %q from Virtual Hub %q (Resource Group %q): %+v", name, virtualHubName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for removal of Connection %q from Virtual Hub %q (Resource Group %q): %+v", name, virtualHubName, resourceGroup, err)
+	}
+
+	return nil
+}
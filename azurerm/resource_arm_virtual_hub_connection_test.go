@@ -0,0 +1,226 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualHubConnection_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_hub_connection.test"
+	ri := testAccRandTimeInt(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualHubConnection_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_internet_security", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualHubConnection_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_virtual_hub_connection.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualHubConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVirtualHubConnection_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_virtual_hub_connection"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualHubConnection_update(t *testing.T) {
+	resourceName := "azurerm_virtual_hub_connection.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualHubConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualHubConnection_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_hub_to_remote_vnet_transit", "true"),
+					resource.TestCheckResourceAttr(resourceName, "enable_internet_security", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualHubConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		id, err := ids.ParseVirtualHubConnectionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).network().hubVnetConnectionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Connection %q to Virtual Hub %q (Resource Group %q) does not exist", id.Name, id.VirtualHubName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on hubVnetConnectionsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualHubConnectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network().hubVnetConnectionsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_hub_connection" {
+			continue
+		}
+
+		id, err := ids.ParseVirtualHubConnectionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Connection %q to Virtual Hub %q (Resource Group %q) still exists", id.Name, id.VirtualHubName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualHubConnection_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["172.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_virtual_wan" "test" {
+  name                = "acctestvwan%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctestvhub%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  address_prefix      = "10.0.1.0/24"
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMVirtualHubConnection_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualHubConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_connection" "test" {
+  name                      = "acctestvhubconn-%d"
+  virtual_hub_id            = "${azurerm_virtual_hub.test.id}"
+  remote_virtual_network_id = "${azurerm_virtual_network.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualHubConnection_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_connection" "import" {
+  name                      = "${azurerm_virtual_hub_connection.test.name}"
+  virtual_hub_id            = "${azurerm_virtual_hub_connection.test.virtual_hub_id}"
+  remote_virtual_network_id = "${azurerm_virtual_hub_connection.test.remote_virtual_network_id}"
+}
+`, testAccAzureRMVirtualHubConnection_basic(rInt, location))
+}
+
+func testAccAzureRMVirtualHubConnection_complete(rInt int, location string) string {
+	template := testAccAzureRMVirtualHubConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_connection" "test" {
+  name                                       = "acctestvhubconn-%d"
+  virtual_hub_id                             = "${azurerm_virtual_hub.test.id}"
+  remote_virtual_network_id                  = "${azurerm_virtual_network.test.id}"
+  allow_hub_to_remote_vnet_transit           = true
+  allow_remote_vnet_to_use_hub_vnet_gateways = false
+  enable_internet_security                   = true
+}
+`, template, rInt)
+}
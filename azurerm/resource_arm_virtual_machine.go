@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
@@ -13,7 +14,9 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"golang.org/x/net/context"
 )
@@ -30,6 +33,13 @@ func resourceArmVirtualMachine() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -76,6 +86,7 @@ func resourceArmVirtualMachine() *schema.Resource {
 					return strings.ToLower(id.(string))
 				},
 				ConflictsWith: []string{"zones"},
+				ValidateFunc:  ids.ValidateOrEmpty(ids.ValidateAvailabilitySetID),
 			},
 
 			"identity": {
@@ -210,6 +221,7 @@ func resourceArmVirtualMachine() *schema.Resource {
 							ForceNew:      true,
 							Computed:      true,
 							ConflictsWith: []string{"storage_os_disk.0.vhd_uri"},
+							ValidateFunc:  ids.ValidateOrEmpty(ids.ValidateManagedDiskID),
 						},
 
 						"managed_disk_type": {
@@ -284,6 +296,7 @@ func resourceArmVirtualMachine() *schema.Resource {
 							Optional:         true,
 							Computed:         true,
 							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc:     ids.ValidateOrEmpty(ids.ValidateManagedDiskID),
 						},
 
 						"managed_disk_type": {
@@ -514,8 +527,9 @@ func resourceArmVirtualMachine() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"source_vault_id": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: ids.ValidateKeyVaultID,
 						},
 
 						"vault_certificates": {
@@ -547,8 +561,9 @@ func resourceArmVirtualMachine() *schema.Resource {
 			},
 
 			"primary_network_interface_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: ids.ValidateOrEmpty(ids.ValidateNetworkInterfaceID),
 			},
 
 			"tags": tagsSchema(),
@@ -557,8 +572,9 @@ func resourceArmVirtualMachine() *schema.Resource {
 }
 
 func resourceArmVirtualMachineCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).compute().vmClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Machine creation.")
 
@@ -712,8 +728,9 @@ func resourceArmVirtualMachineCreateUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
-	vmClient := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	vmClient := meta.(*ArmClient).compute().vmClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -762,7 +779,7 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 			}
 
 			if osDisk := profile.OsDisk; osDisk != nil {
-				diskInfo, err := resourceArmVirtualMachineGetManagedDiskInfo(osDisk.ManagedDisk, meta)
+				diskInfo, err := resourceArmVirtualMachineGetManagedDiskInfo(ctx, osDisk.ManagedDisk, meta)
 				if err != nil {
 					return fmt.Errorf("Error flattening `storage_os_disk`: %#v", err)
 				}
@@ -774,7 +791,7 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 			if dataDisks := profile.DataDisks; dataDisks != nil {
 				disksInfo := make([]*compute.Disk, len(*dataDisks))
 				for i, dataDisk := range *dataDisks {
-					diskInfo, err := resourceArmVirtualMachineGetManagedDiskInfo(dataDisk.ManagedDisk, meta)
+					diskInfo, err := resourceArmVirtualMachineGetManagedDiskInfo(ctx, dataDisk.ManagedDisk, meta)
 					if err != nil {
 						return fmt.Errorf("[DEBUG] Error getting managed data disk detailed information: %#v", err)
 					}
@@ -834,8 +851,9 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).compute().vmClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -867,13 +885,13 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 
 		if osDisk.Vhd != nil {
 			if osDisk.Vhd.URI != nil {
-				if err = resourceArmVirtualMachineDeleteVhd(*osDisk.Vhd.URI, meta); err != nil {
+				if err = resourceArmVirtualMachineDeleteVhd(ctx, *osDisk.Vhd.URI, meta); err != nil {
 					return fmt.Errorf("Error deleting OS Disk VHD: %+v", err)
 				}
 			}
 		} else if osDisk.ManagedDisk != nil {
 			if osDisk.ManagedDisk.ID != nil {
-				if err = resourceArmVirtualMachineDeleteManagedDisk(*osDisk.ManagedDisk.ID, meta); err != nil {
+				if err = resourceArmVirtualMachineDeleteManagedDisk(ctx, *osDisk.ManagedDisk.ID, meta); err != nil {
 					return fmt.Errorf("Error deleting OS Managed Disk: %+v", err)
 				}
 			}
@@ -893,11 +911,11 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 
 		for _, disk := range disks {
 			if disk.Vhd != nil {
-				if err = resourceArmVirtualMachineDeleteVhd(*disk.Vhd.URI, meta); err != nil {
+				if err = resourceArmVirtualMachineDeleteVhd(ctx, *disk.Vhd.URI, meta); err != nil {
 					return fmt.Errorf("Error deleting Data Disk VHD: %+v", err)
 				}
 			} else if disk.ManagedDisk != nil {
-				if err = resourceArmVirtualMachineDeleteManagedDisk(*disk.ManagedDisk.ID, meta); err != nil {
+				if err = resourceArmVirtualMachineDeleteManagedDisk(ctx, *disk.ManagedDisk.ID, meta); err != nil {
 					return fmt.Errorf("Error deleting Data Managed Disk: %+v", err)
 				}
 			} else {
@@ -909,9 +927,8 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceArmVirtualMachineDeleteVhd(uri string, meta interface{}) error {
+func resourceArmVirtualMachineDeleteVhd(ctx context.Context, uri string, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
 	environment := armClient.environment
 
 	vhdURL, err := url.Parse(uri)
@@ -930,7 +947,7 @@ func resourceArmVirtualMachineDeleteVhd(uri string, meta interface{}) error {
 	containerName := path[0]
 	blobName := path[1]
 
-	resourceGroupName, err := findStorageAccountResourceGroup(meta, storageAccountName)
+	resourceGroupName, err := findStorageAccountResourceGroup(ctx, meta, storageAccountName)
 	if err != nil {
 		return fmt.Errorf("Error finding resource group for storage account %s: %+v", storageAccountName, err)
 	}
@@ -957,9 +974,8 @@ func resourceArmVirtualMachineDeleteVhd(uri string, meta interface{}) error {
 	return nil
 }
 
-func resourceArmVirtualMachineDeleteManagedDisk(managedDiskID string, meta interface{}) error {
-	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
+func resourceArmVirtualMachineDeleteManagedDisk(ctx context.Context, managedDiskID string, meta interface{}) error {
+	client := meta.(*ArmClient).compute().diskClient
 
 	id, err := parseAzureResourceID(managedDiskID)
 	if err != nil {
@@ -1744,9 +1760,8 @@ func expandAzureRmVirtualMachineOsDisk(d *schema.ResourceData) (*compute.OSDisk,
 	return osDisk, nil
 }
 
-func findStorageAccountResourceGroup(meta interface{}, storageAccountName string) (string, error) {
-	client := meta.(*ArmClient).resourcesClient
-	ctx := meta.(*ArmClient).StopContext
+func findStorageAccountResourceGroup(ctx context.Context, meta interface{}, storageAccountName string) (string, error) {
+	client := meta.(*ArmClient).resources().resourcesClient
 	filter := fmt.Sprintf("name eq '%s' and resourceType eq 'Microsoft.Storage/storageAccounts'", storageAccountName)
 	expand := ""
 	var pager *int32
@@ -1829,9 +1844,8 @@ func resourceArmVirtualMachineStorageImageReferenceHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
-func resourceArmVirtualMachineGetManagedDiskInfo(disk *compute.ManagedDiskParameters, meta interface{}) (*compute.Disk, error) {
-	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
+func resourceArmVirtualMachineGetManagedDiskInfo(ctx context.Context, disk *compute.ManagedDiskParameters, meta interface{}) (*compute.Disk, error) {
+	client := meta.(*ArmClient).compute().diskClient
 
 	if disk == nil || disk.ID == nil {
 		return nil, nil
@@ -1853,8 +1867,8 @@ func resourceArmVirtualMachineGetManagedDiskInfo(disk *compute.ManagedDiskParame
 	return &diskResp, nil
 }
 func determineVirtualMachineIPAddress(ctx context.Context, meta interface{}, props *compute.VirtualMachineProperties) (string, error) {
-	nicClient := meta.(*ArmClient).ifaceClient
-	pipClient := meta.(*ArmClient).publicIPClient
+	nicClient := meta.(*ArmClient).network().ifaceClient
+	pipClient := meta.(*ArmClient).network().publicIPClient
 
 	if props == nil {
 		return "", nil
@@ -131,7 +131,7 @@ func testCheckAzureRMVirtualMachineExtensionExists(resourceName string) resource
 		vmName := rs.Primary.Attributes["virtual_machine_name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).vmExtensionClient
+		client := testAccProvider.Meta().(*ArmClient).compute().vmExtensionClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, vmName, name, "")
@@ -148,7 +148,7 @@ func testCheckAzureRMVirtualMachineExtensionExists(resourceName string) resource
 }
 
 func testCheckAzureRMVirtualMachineExtensionDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).vmExtensionClient
+	client := testAccProvider.Meta().(*ArmClient).compute().vmExtensionClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
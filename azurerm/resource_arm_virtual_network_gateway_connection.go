@@ -3,13 +3,15 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -25,6 +27,13 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -53,7 +62,7 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: ids.ValidateVirtualNetworkGatewayID,
 			},
 
 			"authorization_key": {
@@ -67,20 +76,20 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+				ValidateFunc: ids.ValidateOrEmpty(ids.ValidateExpressRouteCircuitID),
 			},
 
 			"peer_virtual_network_gateway_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+				ValidateFunc: ids.ValidateOrEmpty(ids.ValidateVirtualNetworkGatewayID),
 			},
 
 			"local_network_gateway_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+				ValidateFunc: ids.ValidateOrEmpty(ids.ValidateLocalNetworkGatewayID),
 			},
 
 			"enable_bgp": {
@@ -114,117 +123,7 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 				Computed: true,
 			},
 
-			"ipsec_policy": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"dh_group": {
-							Type:             schema.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.DHGroup1),
-								string(network.DHGroup14),
-								string(network.DHGroup2),
-								string(network.DHGroup2048),
-								string(network.DHGroup24),
-								string(network.ECP256),
-								string(network.ECP384),
-								string(network.None),
-							}, true),
-						},
-
-						"ike_encryption": {
-							Type:             schema.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.AES128),
-								string(network.AES192),
-								string(network.AES256),
-								string(network.DES),
-								string(network.DES3),
-							}, true),
-						},
-
-						"ike_integrity": {
-							Type:             schema.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.IkeIntegrityGCMAES128),
-								string(network.IkeIntegrityGCMAES256),
-								string(network.IkeIntegrityMD5),
-								string(network.IkeIntegritySHA1),
-								string(network.IkeIntegritySHA256),
-								string(network.IkeIntegritySHA384),
-							}, true),
-						},
-
-						"ipsec_encryption": {
-							Type:             schema.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.IpsecEncryptionAES128),
-								string(network.IpsecEncryptionAES192),
-								string(network.IpsecEncryptionAES256),
-								string(network.IpsecEncryptionDES),
-								string(network.IpsecEncryptionDES3),
-								string(network.IpsecEncryptionGCMAES128),
-								string(network.IpsecEncryptionGCMAES192),
-								string(network.IpsecEncryptionGCMAES256),
-								string(network.IpsecEncryptionNone),
-							}, true),
-						},
-
-						"ipsec_integrity": {
-							Type:             schema.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.IpsecIntegrityGCMAES128),
-								string(network.IpsecIntegrityGCMAES192),
-								string(network.IpsecIntegrityGCMAES256),
-								string(network.IpsecIntegrityMD5),
-								string(network.IpsecIntegritySHA1),
-								string(network.IpsecIntegritySHA256),
-							}, true),
-						},
-
-						"pfs_group": {
-							Type:             schema.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.PfsGroupECP256),
-								string(network.PfsGroupECP384),
-								string(network.PfsGroupNone),
-								string(network.PfsGroupPFS1),
-								string(network.PfsGroupPFS2),
-								string(network.PfsGroupPFS2048),
-								string(network.PfsGroupPFS24),
-							}, true),
-						},
-
-						"sa_datasize": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.IntAtLeast(1024),
-						},
-
-						"sa_lifetime": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.IntAtLeast(300),
-						},
-					},
-				},
-			},
+			"ipsec_policy": ipsecPolicySchema(),
 
 			"tags": tagsSchema(),
 		},
@@ -232,8 +131,9 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 }
 
 func resourceArmVirtualNetworkGatewayConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vnetGatewayConnectionsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().vnetGatewayConnectionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Virtual Network Gateway Connection creation.")
 
@@ -291,8 +191,9 @@ func resourceArmVirtualNetworkGatewayConnectionCreateUpdate(d *schema.ResourceDa
 }
 
 func resourceArmVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vnetGatewayConnectionsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().vnetGatewayConnectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resGroup, name, err := resourceGroupAndVirtualNetworkGatewayConnectionFromId(d.Id())
 	if err != nil {
@@ -374,8 +275,9 @@ func resourceArmVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta
 }
 
 func resourceArmVirtualNetworkGatewayConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vnetGatewayConnectionsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).network().vnetGatewayConnectionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resGroup, name, err := resourceGroupAndVirtualNetworkGatewayConnectionFromId(d.Id())
 	if err != nil {
@@ -589,3 +491,118 @@ func flattenArmVirtualNetworkGatewayConnectionIpsecPolicies(ipsecPolicies *[]net
 
 	return schemaIpsecPolicies
 }
+
+// ipsecPolicySchema returns the schema for a custom IPsec Policy used by a VPN Connection
+func ipsecPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"dh_group": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppress.CaseDifference,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.DHGroup1),
+						string(network.DHGroup14),
+						string(network.DHGroup2),
+						string(network.DHGroup2048),
+						string(network.DHGroup24),
+						string(network.ECP256),
+						string(network.ECP384),
+						string(network.None),
+					}, true),
+				},
+
+				"ike_encryption": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppress.CaseDifference,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.AES128),
+						string(network.AES192),
+						string(network.AES256),
+						string(network.DES),
+						string(network.DES3),
+					}, true),
+				},
+
+				"ike_integrity": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppress.CaseDifference,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.IkeIntegrityGCMAES128),
+						string(network.IkeIntegrityGCMAES256),
+						string(network.IkeIntegrityMD5),
+						string(network.IkeIntegritySHA1),
+						string(network.IkeIntegritySHA256),
+						string(network.IkeIntegritySHA384),
+					}, true),
+				},
+
+				"ipsec_encryption": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppress.CaseDifference,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.IpsecEncryptionAES128),
+						string(network.IpsecEncryptionAES192),
+						string(network.IpsecEncryptionAES256),
+						string(network.IpsecEncryptionDES),
+						string(network.IpsecEncryptionDES3),
+						string(network.IpsecEncryptionGCMAES128),
+						string(network.IpsecEncryptionGCMAES192),
+						string(network.IpsecEncryptionGCMAES256),
+						string(network.IpsecEncryptionNone),
+					}, true),
+				},
+
+				"ipsec_integrity": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppress.CaseDifference,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.IpsecIntegrityGCMAES128),
+						string(network.IpsecIntegrityGCMAES192),
+						string(network.IpsecIntegrityGCMAES256),
+						string(network.IpsecIntegrityMD5),
+						string(network.IpsecIntegritySHA1),
+						string(network.IpsecIntegritySHA256),
+					}, true),
+				},
+
+				"pfs_group": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppress.CaseDifference,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.PfsGroupECP256),
+						string(network.PfsGroupECP384),
+						string(network.PfsGroupNone),
+						string(network.PfsGroupPFS1),
+						string(network.PfsGroupPFS2),
+						string(network.PfsGroupPFS2048),
+						string(network.PfsGroupPFS24),
+					}, true),
+				},
+
+				"sa_datasize": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(1024),
+				},
+
+				"sa_lifetime": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(300),
+				},
+			},
+		},
+	}
+}
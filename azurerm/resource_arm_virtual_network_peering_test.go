@@ -10,6 +10,31 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
+func TestValidateVirtualNetworkPeeringRemoteVirtualNetworkID(t *testing.T) {
+	validateFunc := resourceArmVirtualNetworkPeering().Schema["remote_virtual_network_id"].ValidateFunc
+
+	validIds := []string{
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ClassicNetwork/virtualNetworks/network1",
+	}
+	for _, v := range validIds {
+		if _, errors := validateFunc(v, "remote_virtual_network_id"); len(errors) != 0 {
+			t.Fatalf("%q should be a valid Remote Virtual Network ID: %+v", v, errors)
+		}
+	}
+
+	invalidIds := []string{
+		"",
+		"network1",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1",
+	}
+	for _, v := range invalidIds {
+		if _, errors := validateFunc(v, "remote_virtual_network_id"); len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Remote Virtual Network ID", v)
+		}
+	}
+}
+
 func TestAccAzureRMVirtualNetworkPeering_basic(t *testing.T) {
 	firstResourceName := "azurerm_virtual_network_peering.test1"
 	secondResourceName := "azurerm_virtual_network_peering.test2"
@@ -154,7 +179,7 @@ func testCheckAzureRMVirtualNetworkPeeringExists(resourceName string) resource.T
 		}
 
 		// Ensure resource group/virtual network peering combination exists in API
-		client := testAccProvider.Meta().(*ArmClient).vnetPeeringsClient
+		client := testAccProvider.Meta().(*ArmClient).network().vnetPeeringsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, vnetName, name)
@@ -186,7 +211,7 @@ func testCheckAzureRMVirtualNetworkPeeringDisappears(resourceName string) resour
 		}
 
 		// Ensure resource group/virtual network peering combination exists in API
-		client := testAccProvider.Meta().(*ArmClient).vnetPeeringsClient
+		client := testAccProvider.Meta().(*ArmClient).network().vnetPeeringsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		future, err := client.Delete(ctx, resourceGroup, vnetName, name)
@@ -203,7 +228,7 @@ func testCheckAzureRMVirtualNetworkPeeringDisappears(resourceName string) resour
 }
 
 func testCheckAzureRMVirtualNetworkPeeringDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).vnetPeeringsClient
+	client := testAccProvider.Meta().(*ArmClient).network().vnetPeeringsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -0,0 +1,231 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVirtualNetworkTap() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualNetworkTapCreateUpdate,
+		Read:   resourceArmVirtualNetworkTapRead,
+		Update: resourceArmVirtualNetworkTapCreateUpdate,
+		Delete: resourceArmVirtualNetworkTapDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"destination_network_interface_ip_configuration_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  ids.ValidateNetworkInterfaceIPConfigurationID,
+				ConflictsWith: []string{"destination_load_balancer_frontend_ip_configuration_id"},
+			},
+
+			"destination_load_balancer_frontend_ip_configuration_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  ids.ValidateLoadBalancerFrontendIPConfigurationID,
+				ConflictsWith: []string{"destination_network_interface_ip_configuration_id"},
+			},
+
+			"destination_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4789,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmVirtualNetworkTapCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().vnetTapsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Virtual Network TAP creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Virtual Network TAP %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_virtual_network_tap", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	tap := network.VirtualNetworkTap{
+		Location: utils.String(location),
+		VirtualNetworkTapPropertiesFormat: &network.VirtualNetworkTapPropertiesFormat{
+			DestinationPort: utils.Int32(int32(d.Get("destination_port").(int))),
+		},
+		Tags: expandTags(tags),
+	}
+
+	networkInterfaceIPConfigurationId := d.Get("destination_network_interface_ip_configuration_id").(string)
+	loadBalancerFrontendIPConfigurationId := d.Get("destination_load_balancer_frontend_ip_configuration_id").(string)
+	if networkInterfaceIPConfigurationId == "" && loadBalancerFrontendIPConfigurationId == "" {
+		return fmt.Errorf("One of `destination_network_interface_ip_configuration_id` or `destination_load_balancer_frontend_ip_configuration_id` must be specified")
+	}
+
+	if networkInterfaceIPConfigurationId != "" {
+		tap.VirtualNetworkTapPropertiesFormat.DestinationNetworkInterfaceIPConfiguration = &network.InterfaceIPConfiguration{
+			ID: utils.String(networkInterfaceIPConfigurationId),
+		}
+	}
+
+	if loadBalancerFrontendIPConfigurationId != "" {
+		tap.VirtualNetworkTapPropertiesFormat.DestinationLoadBalancerFrontEndIPConfiguration = &network.FrontendIPConfiguration{
+			ID: utils.String(loadBalancerFrontendIPConfigurationId),
+		}
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, tap)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Virtual Network TAP %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Virtual Network TAP %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Network TAP %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Virtual Network TAP %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualNetworkTapRead(d, meta)
+}
+
+func resourceArmVirtualNetworkTapRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().vnetTapsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseVirtualNetworkTapID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Virtual Network TAP %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Virtual Network TAP %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.VirtualNetworkTapPropertiesFormat; props != nil {
+		networkInterfaceIPConfigurationId := ""
+		if config := props.DestinationNetworkInterfaceIPConfiguration; config != nil && config.ID != nil {
+			networkInterfaceIPConfigurationId = *config.ID
+		}
+		d.Set("destination_network_interface_ip_configuration_id", networkInterfaceIPConfigurationId)
+
+		loadBalancerFrontendIPConfigurationId := ""
+		if config := props.DestinationLoadBalancerFrontEndIPConfiguration; config != nil && config.ID != nil {
+			loadBalancerFrontendIPConfigurationId = *config.ID
+		}
+		d.Set("destination_load_balancer_frontend_ip_configuration_id", loadBalancerFrontendIPConfigurationId)
+
+		destinationPort := 0
+		if props.DestinationPort != nil {
+			destinationPort = int(*props.DestinationPort)
+		}
+		d.Set("destination_port", destinationPort)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmVirtualNetworkTapDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().vnetTapsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseVirtualNetworkTapID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Virtual Network TAP %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of Virtual Network TAP %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,247 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualNetworkTap_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_network_tap.test"
+	ri := testAccRandTimeInt(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkTapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualNetworkTap_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkTapExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "destination_network_interface_ip_configuration_id"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port", "4789"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualNetworkTap_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_virtual_network_tap.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkTapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualNetworkTap_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkTapExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVirtualNetworkTap_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_virtual_network_tap"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualNetworkTap_loadBalancer(t *testing.T) {
+	resourceName := "azurerm_virtual_network_tap.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkTapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualNetworkTap_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkTapExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualNetworkTap_loadBalancer(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkTapExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_network_interface_ip_configuration_id", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "destination_load_balancer_frontend_ip_configuration_id"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port", "4790"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualNetworkTapExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).network().vnetTapsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Virtual Network TAP %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on vnetTapsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualNetworkTapDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network().vnetTapsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_network_tap" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Virtual Network TAP %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualNetworkTap_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsn-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_network_interface" "destination" {
+  name                = "acctestnic-dest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMVirtualNetworkTap_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualNetworkTap_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network_tap" "test" {
+  name                                              = "acctestvntap-%d"
+  location                                          = "${azurerm_resource_group.test.location}"
+  resource_group_name                               = "${azurerm_resource_group.test.name}"
+  destination_network_interface_ip_configuration_id = "${azurerm_network_interface.destination.id}/ipConfigurations/internal"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualNetworkTap_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network_tap" "import" {
+  name                                              = "${azurerm_virtual_network_tap.test.name}"
+  location                                          = "${azurerm_virtual_network_tap.test.location}"
+  resource_group_name                               = "${azurerm_virtual_network_tap.test.resource_group_name}"
+  destination_network_interface_ip_configuration_id = "${azurerm_virtual_network_tap.test.destination_network_interface_ip_configuration_id}"
+}
+`, testAccAzureRMVirtualNetworkTap_basic(rInt, location))
+}
+
+func testAccAzureRMVirtualNetworkTap_loadBalancer(rInt int, location string) string {
+	template := testAccAzureRMVirtualNetworkTap_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lb" "test" {
+  name                = "acctestlb-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+
+  frontend_ip_configuration {
+    name                          = "internal"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_virtual_network_tap" "test" {
+  name                                                   = "acctestvntap-%d"
+  location                                               = "${azurerm_resource_group.test.location}"
+  resource_group_name                                    = "${azurerm_resource_group.test.name}"
+  destination_load_balancer_frontend_ip_configuration_id = "${azurerm_lb.test.id}/frontendIPConfigurations/internal"
+  destination_port                                       = 4790
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, template, rInt, rInt)
+}
@@ -0,0 +1,205 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualWan_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_wan.test"
+	ri := testAccRandTimeInt(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualWanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualWan_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualWanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_branch_to_branch_traffic", "true"),
+					resource.TestCheckResourceAttr(resourceName, "office365_local_breakout_category", "None"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualWan_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_virtual_wan.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualWanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualWan_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualWanExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVirtualWan_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_virtual_wan"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualWan_update(t *testing.T) {
+	resourceName := "azurerm_virtual_wan.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualWanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualWan_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualWanExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualWan_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualWanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "disable_vpn_encryption", "true"),
+					resource.TestCheckResourceAttr(resourceName, "allow_branch_to_branch_traffic", "false"),
+					resource.TestCheckResourceAttr(resourceName, "allow_vnet_to_vnet_traffic", "true"),
+					resource.TestCheckResourceAttr(resourceName, "office365_local_breakout_category", "All"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualWanExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).network().virtualWansClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Virtual WAN %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on virtualWansClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualWanDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network().virtualWansClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_wan" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Virtual WAN %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualWan_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_wan" "test" {
+  name                = "acctestvwan%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMVirtualWan_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_wan" "import" {
+  name                = "${azurerm_virtual_wan.test.name}"
+  resource_group_name = "${azurerm_virtual_wan.test.resource_group_name}"
+  location            = "${azurerm_virtual_wan.test.location}"
+}
+`, testAccAzureRMVirtualWan_basic(rInt, location))
+}
+
+func testAccAzureRMVirtualWan_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_wan" "test" {
+  name                = "acctestvwan%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  disable_vpn_encryption            = true
+  allow_branch_to_branch_traffic    = false
+  allow_vnet_to_vnet_traffic        = true
+  office365_local_breakout_category = "All"
+
+  tags = {
+    Hello = "There"
+  }
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,289 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var vpnGatewayResourceName = "azurerm_vpn_gateway"
+
+func resourceArmVpnGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVpnGatewayCreateUpdate,
+		Read:   resourceArmVpnGatewayRead,
+		Update: resourceArmVpnGatewayCreateUpdate,
+		Delete: resourceArmVpnGatewayDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"virtual_hub_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ids.ValidateVirtualHubID,
+			},
+
+			"scale_unit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"bgp_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asn": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"peer_weight": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+
+						"bgp_peering_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmVpnGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().vpnGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for VPN Gateway creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	azureRMLockByName(name, vpnGatewayResourceName)
+	defer azureRMUnlockByName(name, vpnGatewayResourceName)
+
+	existing, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_vpn_gateway", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	gateway := network.VpnGateway{
+		Location: utils.String(location),
+		VpnGatewayProperties: &network.VpnGatewayProperties{
+			VirtualHub: &network.SubResource{
+				ID: utils.String(d.Get("virtual_hub_id").(string)),
+			},
+			BgpSettings:         expandArmVpnGatewayBgpSettings(d.Get("bgp_settings").([]interface{})),
+			VpnGatewayScaleUnit: utils.Int32(int32(d.Get("scale_unit").(int))),
+		},
+		Tags: expandTags(tags),
+	}
+
+	// the VPN Connections are managed using the `azurerm_vpn_gateway_connection` resource
+	if props := existing.VpnGatewayProperties; props != nil {
+		gateway.VpnGatewayProperties.Connections = props.Connections
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, gateway)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read VPN Gateway %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVpnGatewayRead(d, meta)
+}
+
+func resourceArmVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().vpnGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseVpnGatewayID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] VPN Gateway %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.VpnGatewayProperties; props != nil {
+		virtualHubId := ""
+		if props.VirtualHub != nil && props.VirtualHub.ID != nil {
+			virtualHubId = *props.VirtualHub.ID
+		}
+		d.Set("virtual_hub_id", virtualHubId)
+
+		scaleUnit := 0
+		if props.VpnGatewayScaleUnit != nil {
+			scaleUnit = int(*props.VpnGatewayScaleUnit)
+		}
+		d.Set("scale_unit", scaleUnit)
+
+		if err := d.Set("bgp_settings", flattenArmVpnGatewayBgpSettings(props.BgpSettings)); err != nil {
+			return fmt.Errorf("Error setting `bgp_settings`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmVpnGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().vpnGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseVpnGatewayID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	azureRMLockByName(name, vpnGatewayResourceName)
+	defer azureRMUnlockByName(name, vpnGatewayResourceName)
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmVpnGatewayBgpSettings(input []interface{}) *network.BgpSettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &network.BgpSettings{
+		Asn:        utils.Int64(int64(v["asn"].(int))),
+		PeerWeight: utils.Int32(int32(v["peer_weight"].(int))),
+	}
+}
+
+func flattenArmVpnGatewayBgpSettings(input *network.BgpSettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	asn := 0
+	if input.Asn != nil {
+		asn = int(*input.Asn)
+	}
+
+	peerWeight := 0
+	if input.PeerWeight != nil {
+		peerWeight = int(*input.PeerWeight)
+	}
+
+	bgpPeeringAddress := ""
+	if input.BgpPeeringAddress != nil {
+		bgpPeeringAddress = *input.BgpPeeringAddress
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"asn":                 asn,
+			"peer_weight":         peerWeight,
+			"bgp_peering_address": bgpPeeringAddress,
+		},
+	}
+}
@@ -0,0 +1,228 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVpnGatewayConnection_basic(t *testing.T) {
+	resourceName := "azurerm_vpn_gateway_connection.test"
+	ri := testAccRandTimeInt(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnGatewayConnection_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnGatewayConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "IKEv2"),
+					resource.TestCheckResourceAttrSet(resourceName, "connection_status"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"shared_key"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVpnGatewayConnection_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_vpn_gateway_connection.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnGatewayConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnGatewayConnectionExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVpnGatewayConnection_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_vpn_gateway_connection"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVpnGatewayConnection_ipsecPolicy(t *testing.T) {
+	resourceName := "azurerm_vpn_gateway_connection.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnGatewayConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnGatewayConnectionExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMVpnGatewayConnection_ipsecPolicy(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnGatewayConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "routing_weight", "10"),
+					resource.TestCheckResourceAttr(resourceName, "ipsec_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ipsec_policy.0.ipsec_encryption", "AES256"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"shared_key"},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVpnGatewayConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		id, err := ids.ParseVpnGatewayConnectionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).network().vpnConnectionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.VpnGatewayName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Connection %q (VPN Gateway %q / Resource Group %q) does not exist", id.Name, id.VpnGatewayName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on vpnConnectionsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVpnGatewayConnectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network().vpnConnectionsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_vpn_gateway_connection" {
+			continue
+		}
+
+		id, err := ids.ParseVpnGatewayConnectionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.VpnGatewayName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Connection %q (VPN Gateway %q / Resource Group %q) still exists", id.Name, id.VpnGatewayName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVpnGatewayConnection_template(rInt int, location string) string {
+	template := testAccAzureRMVpnGateway_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_site" "test" {
+  name                = "acctestvpnsite%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  ip_address          = "10.1.0.1"
+  address_cidrs       = ["10.1.0.0/24"]
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVpnGatewayConnection_basic(rInt int, location string) string {
+	template := testAccAzureRMVpnGatewayConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway_connection" "test" {
+  name               = "acctestvpnconn%d"
+  vpn_gateway_id     = "${azurerm_vpn_gateway.test.id}"
+  remote_vpn_site_id = "${azurerm_vpn_site.test.id}"
+  shared_key         = "4-v3ry-53cr37-1p53c-5h4r3d-k3y"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVpnGatewayConnection_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway_connection" "import" {
+  name               = "${azurerm_vpn_gateway_connection.test.name}"
+  vpn_gateway_id     = "${azurerm_vpn_gateway_connection.test.vpn_gateway_id}"
+  remote_vpn_site_id = "${azurerm_vpn_gateway_connection.test.remote_vpn_site_id}"
+  shared_key         = "4-v3ry-53cr37-1p53c-5h4r3d-k3y"
+}
+`, testAccAzureRMVpnGatewayConnection_basic(rInt, location))
+}
+
+func testAccAzureRMVpnGatewayConnection_ipsecPolicy(rInt int, location string) string {
+	template := testAccAzureRMVpnGatewayConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway_connection" "test" {
+  name               = "acctestvpnconn%d"
+  vpn_gateway_id     = "${azurerm_vpn_gateway.test.id}"
+  remote_vpn_site_id = "${azurerm_vpn_site.test.id}"
+  shared_key         = "4-v3ry-53cr37-1p53c-5h4r3d-k3y"
+  routing_weight     = 10
+
+  ipsec_policy {
+    dh_group         = "DHGroup14"
+    ike_encryption   = "AES256"
+    ike_integrity    = "SHA256"
+    ipsec_encryption = "AES256"
+    ipsec_integrity  = "SHA256"
+    pfs_group        = "PFS2048"
+    sa_datasize      = 102400000
+    sa_lifetime      = 27000
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,357 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVpnSite() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVpnSiteCreateUpdate,
+		Read:   resourceArmVpnSiteRead,
+		Update: resourceArmVpnSiteCreateUpdate,
+		Delete: resourceArmVpnSiteDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"virtual_wan_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ids.ValidateVirtualWanID,
+			},
+
+			"ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.IPv4Address,
+			},
+
+			"address_cidrs": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.CIDR,
+				},
+			},
+
+			"device_vendor": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"device_model": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"link_speed_in_mbps": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"bgp": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asn": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"peering_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.IPv4Address,
+						},
+
+						"peer_weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+					},
+				},
+			},
+
+			"site_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"is_security_site": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmVpnSiteCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().vpnSitesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for VPN Site creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_vpn_site", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	addressPrefixes := make([]string, 0)
+	for _, v := range d.Get("address_cidrs").([]interface{}) {
+		addressPrefixes = append(addressPrefixes, v.(string))
+	}
+
+	site := network.VpnSite{
+		Location: utils.String(location),
+		VpnSiteProperties: &network.VpnSiteProperties{
+			VirtualWan: &network.SubResource{
+				ID: utils.String(d.Get("virtual_wan_id").(string)),
+			},
+			IPAddress: utils.String(d.Get("ip_address").(string)),
+			AddressSpace: &network.AddressSpace{
+				AddressPrefixes: &addressPrefixes,
+			},
+			DeviceProperties: &network.DeviceProperties{
+				LinkSpeedInMbps: utils.Int32(int32(d.Get("link_speed_in_mbps").(int))),
+			},
+			BgpProperties:  expandArmVpnSiteBgpProperties(d.Get("bgp").([]interface{})),
+			IsSecuritySite: utils.Bool(d.Get("is_security_site").(bool)),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if v := d.Get("device_vendor").(string); v != "" {
+		site.VpnSiteProperties.DeviceProperties.DeviceVendor = utils.String(v)
+	}
+
+	if v := d.Get("device_model").(string); v != "" {
+		site.VpnSiteProperties.DeviceProperties.DeviceModel = utils.String(v)
+	}
+
+	if v := d.Get("site_key").(string); v != "" {
+		site.VpnSiteProperties.SiteKey = utils.String(v)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, site)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read VPN Site %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVpnSiteRead(d, meta)
+}
+
+func resourceArmVpnSiteRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().vpnSitesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseVpnSiteID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] VPN Site %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.VpnSiteProperties; props != nil {
+		virtualWanId := ""
+		if props.VirtualWan != nil && props.VirtualWan.ID != nil {
+			virtualWanId = *props.VirtualWan.ID
+		}
+		d.Set("virtual_wan_id", virtualWanId)
+		d.Set("ip_address", props.IPAddress)
+		d.Set("is_security_site", props.IsSecuritySite)
+
+		addressCidrs := make([]string, 0)
+		if props.AddressSpace != nil && props.AddressSpace.AddressPrefixes != nil {
+			addressCidrs = *props.AddressSpace.AddressPrefixes
+		}
+		if err := d.Set("address_cidrs", addressCidrs); err != nil {
+			return fmt.Errorf("Error setting `address_cidrs`: %+v", err)
+		}
+
+		if device := props.DeviceProperties; device != nil {
+			d.Set("device_vendor", device.DeviceVendor)
+			d.Set("device_model", device.DeviceModel)
+
+			linkSpeed := 0
+			if device.LinkSpeedInMbps != nil {
+				linkSpeed = int(*device.LinkSpeedInMbps)
+			}
+			d.Set("link_speed_in_mbps", linkSpeed)
+		}
+
+		if err := d.Set("bgp", flattenArmVpnSiteBgpProperties(props.BgpProperties)); err != nil {
+			return fmt.Errorf("Error setting `bgp`: %+v", err)
+		}
+
+		// the Site Key isn't returned by the API, so we keep the value from the config
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmVpnSiteDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().vpnSitesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseVpnSiteID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmVpnSiteBgpProperties(input []interface{}) *network.BgpSettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &network.BgpSettings{
+		Asn:               utils.Int64(int64(v["asn"].(int))),
+		BgpPeeringAddress: utils.String(v["peering_address"].(string)),
+		PeerWeight:        utils.Int32(int32(v["peer_weight"].(int))),
+	}
+}
+
+func flattenArmVpnSiteBgpProperties(input *network.BgpSettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	asn := 0
+	if input.Asn != nil {
+		asn = int(*input.Asn)
+	}
+
+	peeringAddress := ""
+	if input.BgpPeeringAddress != nil {
+		peeringAddress = *input.BgpPeeringAddress
+	}
+
+	peerWeight := 0
+	if input.PeerWeight != nil {
+		peerWeight = int(*input.PeerWeight)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"asn":             asn,
+			"peering_address": peeringAddress,
+			"peer_weight":     peerWeight,
+		},
+	}
+}
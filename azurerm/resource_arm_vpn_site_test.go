@@ -0,0 +1,212 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVpnSite_basic(t *testing.T) {
+	resourceName := "azurerm_vpn_site.test"
+	ri := testAccRandTimeInt(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnSite_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnSiteExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ip_address", "10.1.0.1"),
+					resource.TestCheckResourceAttr(resourceName, "address_cidrs.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVpnSite_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_vpn_site.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnSite_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnSiteExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVpnSite_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_vpn_site"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVpnSite_complete(t *testing.T) {
+	resourceName := "azurerm_vpn_site.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnSite_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnSiteExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMVpnSite_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnSiteExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "address_cidrs.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "device_vendor", "Microsoft"),
+					resource.TestCheckResourceAttr(resourceName, "link_speed_in_mbps", "50"),
+					resource.TestCheckResourceAttr(resourceName, "bgp.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bgp.0.asn", "65010"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"site_key"},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVpnSiteExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).network().vpnSitesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: VPN Site %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on vpnSitesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVpnSiteDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network().vpnSitesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_vpn_site" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("VPN Site %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVpnSite_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualHub_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_site" "test" {
+  name                = "acctestvpnsite%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  ip_address          = "10.1.0.1"
+  address_cidrs       = ["10.1.0.0/24"]
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVpnSite_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_site" "import" {
+  name                = "${azurerm_vpn_site.test.name}"
+  resource_group_name = "${azurerm_vpn_site.test.resource_group_name}"
+  location            = "${azurerm_vpn_site.test.location}"
+  virtual_wan_id      = "${azurerm_vpn_site.test.virtual_wan_id}"
+  ip_address          = "${azurerm_vpn_site.test.ip_address}"
+  address_cidrs       = ["10.1.0.0/24"]
+}
+`, testAccAzureRMVpnSite_basic(rInt, location))
+}
+
+func testAccAzureRMVpnSite_complete(rInt int, location string) string {
+	template := testAccAzureRMVirtualHub_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_site" "test" {
+  name                = "acctestvpnsite%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  ip_address          = "10.1.0.1"
+  address_cidrs       = ["10.1.0.0/24", "10.2.0.0/24"]
+  device_vendor       = "Microsoft"
+  device_model        = "AzureVPNGateway"
+  link_speed_in_mbps  = 50
+  site_key            = "s1t3k3y"
+
+  bgp {
+    asn             = 65010
+    peering_address = "10.1.0.1"
+    peer_weight     = 10
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,511 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmWebApplicationFirewallPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmWebApplicationFirewallPolicyCreateUpdate,
+		Read:   resourceArmWebApplicationFirewallPolicyRead,
+		Update: resourceArmWebApplicationFirewallPolicyCreateUpdate,
+		Delete: resourceArmWebApplicationFirewallPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"policy_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(network.WebApplicationFirewallModePrevention),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.WebApplicationFirewallModeDetection),
+								string(network.WebApplicationFirewallModePrevention),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"custom_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"rule_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(network.WebApplicationFirewallRuleTypeMatchRule),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.WebApplicationFirewallRuleTypeMatchRule),
+							}, false),
+						},
+
+						"action": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.WebApplicationFirewallActionAllow),
+								string(network.WebApplicationFirewallActionBlock),
+								string(network.WebApplicationFirewallActionLog),
+							}, false),
+						},
+
+						"match_condition": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"match_variable": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"variable_name": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(network.PostArgs),
+														string(network.QueryString),
+														string(network.RemoteAddr),
+														string(network.RequestBody),
+														string(network.RequestCookies),
+														string(network.RequestHeaders),
+														string(network.RequestMethod),
+														string(network.RequestURI),
+													}, false),
+												},
+
+												"selector": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validate.NoEmptyStrings,
+												},
+											},
+										},
+									},
+
+									"operator": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(network.WebApplicationFirewallOperatorBeginsWith),
+											string(network.WebApplicationFirewallOperatorContains),
+											string(network.WebApplicationFirewallOperatorEndsWith),
+											string(network.WebApplicationFirewallOperatorEqual),
+											string(network.WebApplicationFirewallOperatorGreaterThan),
+											string(network.WebApplicationFirewallOperatorGreaterThanOrEqual),
+											string(network.WebApplicationFirewallOperatorIPMatch),
+											string(network.WebApplicationFirewallOperatorLessThan),
+											string(network.WebApplicationFirewallOperatorLessThanOrEqual),
+											string(network.WebApplicationFirewallOperatorRegex),
+										}, false),
+									},
+
+									"negation_condition": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"match_values": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+									},
+
+									"transforms": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(network.HTMLEntityDecode),
+												string(network.Lowercase),
+												string(network.RemoveNulls),
+												string(network.Trim),
+												string(network.URLDecode),
+												string(network.URLEncode),
+											}, false),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"application_gateway_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmWebApplicationFirewallPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().wafPoliciesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Web Application Firewall Policy creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Web Application Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_web_application_firewall_policy", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := network.WebApplicationFirewallPolicy{
+		Location: utils.String(location),
+		WebApplicationFirewallPolicyPropertiesFormat: &network.WebApplicationFirewallPolicyPropertiesFormat{
+			PolicySettings: expandArmWebApplicationFirewallPolicySettings(d.Get("policy_settings").([]interface{})),
+			CustomRules:    expandArmWebApplicationFirewallPolicyCustomRules(d.Get("custom_rule").([]interface{})),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Web Application Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Web Application Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Web Application Firewall Policy %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmWebApplicationFirewallPolicyRead(d, meta)
+}
+
+func resourceArmWebApplicationFirewallPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().wafPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseWebApplicationFirewallPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Web Application Firewall Policy %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Web Application Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.WebApplicationFirewallPolicyPropertiesFormat; props != nil {
+		if err := d.Set("policy_settings", flattenArmWebApplicationFirewallPolicySettings(props.PolicySettings)); err != nil {
+			return fmt.Errorf("Error setting `policy_settings`: %+v", err)
+		}
+
+		if err := d.Set("custom_rule", flattenArmWebApplicationFirewallPolicyCustomRules(props.CustomRules)); err != nil {
+			return fmt.Errorf("Error setting `custom_rule`: %+v", err)
+		}
+
+		applicationGatewayIds := make([]interface{}, 0)
+		if gateways := props.ApplicationGateways; gateways != nil {
+			for _, gateway := range *gateways {
+				if gateway.ID != nil {
+					applicationGatewayIds = append(applicationGatewayIds, *gateway.ID)
+				}
+			}
+		}
+		if err := d.Set("application_gateway_ids", applicationGatewayIds); err != nil {
+			return fmt.Errorf("Error setting `application_gateway_ids`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmWebApplicationFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).network().wafPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := ids.ParseWebApplicationFirewallPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Name
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Web Application Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of Web Application Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmWebApplicationFirewallPolicySettings(input []interface{}) *network.PolicySettings {
+	// the API defaults to an enabled policy in Prevention mode when no settings are specified
+	settings := network.PolicySettings{
+		EnabledState: network.WebApplicationFirewallEnabledStateEnabled,
+		Mode:         network.WebApplicationFirewallModePrevention,
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return &settings
+	}
+
+	v := input[0].(map[string]interface{})
+	if !v["enabled"].(bool) {
+		settings.EnabledState = network.WebApplicationFirewallEnabledStateDisabled
+	}
+	settings.Mode = network.WebApplicationFirewallMode(v["mode"].(string))
+
+	return &settings
+}
+
+func expandArmWebApplicationFirewallPolicyCustomRules(input []interface{}) *[]network.WebApplicationFirewallCustomRule {
+	rules := make([]network.WebApplicationFirewallCustomRule, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		rules = append(rules, network.WebApplicationFirewallCustomRule{
+			Name:            utils.String(v["name"].(string)),
+			Priority:        utils.Int32(int32(v["priority"].(int))),
+			RuleType:        network.WebApplicationFirewallRuleType(v["rule_type"].(string)),
+			Action:          network.WebApplicationFirewallAction(v["action"].(string)),
+			MatchConditions: expandArmWebApplicationFirewallPolicyMatchConditions(v["match_condition"].([]interface{})),
+		})
+	}
+
+	return &rules
+}
+
+func expandArmWebApplicationFirewallPolicyMatchConditions(input []interface{}) *[]network.MatchCondition {
+	conditions := make([]network.MatchCondition, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		variables := make([]network.MatchVariable, 0)
+		for _, variable := range v["match_variable"].([]interface{}) {
+			vv := variable.(map[string]interface{})
+
+			matchVariable := network.MatchVariable{
+				VariableName: network.WebApplicationFirewallMatchVariable(vv["variable_name"].(string)),
+			}
+			if selector := vv["selector"].(string); selector != "" {
+				matchVariable.Selector = utils.String(selector)
+			}
+
+			variables = append(variables, matchVariable)
+		}
+
+		transforms := make([]network.WebApplicationFirewallTransform, 0)
+		for _, transform := range v["transforms"].(*schema.Set).List() {
+			transforms = append(transforms, network.WebApplicationFirewallTransform(transform.(string)))
+		}
+
+		conditions = append(conditions, network.MatchCondition{
+			MatchVariables:   &variables,
+			Operator:         network.WebApplicationFirewallOperator(v["operator"].(string)),
+			NegationConditon: utils.Bool(v["negation_condition"].(bool)),
+			MatchValues:      utils.ExpandStringArray(v["match_values"].([]interface{})),
+			Transforms:       &transforms,
+		})
+	}
+
+	return &conditions
+}
+
+func flattenArmWebApplicationFirewallPolicySettings(input *network.PolicySettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled": input.EnabledState == network.WebApplicationFirewallEnabledStateEnabled,
+			"mode":    string(input.Mode),
+		},
+	}
+}
+
+func flattenArmWebApplicationFirewallPolicyCustomRules(input *[]network.WebApplicationFirewallCustomRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, rule := range *input {
+		output := map[string]interface{}{
+			"rule_type":       string(rule.RuleType),
+			"action":          string(rule.Action),
+			"match_condition": flattenArmWebApplicationFirewallPolicyMatchConditions(rule.MatchConditions),
+		}
+
+		if rule.Name != nil {
+			output["name"] = *rule.Name
+		}
+		if rule.Priority != nil {
+			output["priority"] = int(*rule.Priority)
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenArmWebApplicationFirewallPolicyMatchConditions(input *[]network.MatchCondition) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, condition := range *input {
+		variables := make([]interface{}, 0)
+		if condition.MatchVariables != nil {
+			for _, variable := range *condition.MatchVariables {
+				selector := ""
+				if variable.Selector != nil {
+					selector = *variable.Selector
+				}
+
+				variables = append(variables, map[string]interface{}{
+					"variable_name": string(variable.VariableName),
+					"selector":      selector,
+				})
+			}
+		}
+
+		transforms := make([]interface{}, 0)
+		if condition.Transforms != nil {
+			for _, transform := range *condition.Transforms {
+				transforms = append(transforms, string(transform))
+			}
+		}
+
+		negationCondition := false
+		if condition.NegationConditon != nil {
+			negationCondition = *condition.NegationConditon
+		}
+
+		results = append(results, map[string]interface{}{
+			"match_variable":     variables,
+			"operator":           string(condition.Operator),
+			"negation_condition": negationCondition,
+			"match_values":       utils.FlattenStringArray(condition.MatchValues),
+			"transforms":         schema.NewSet(schema.HashString, transforms),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,278 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMWebApplicationFirewallPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_web_application_firewall_policy.test"
+	ri := testAccRandTimeInt(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWebApplicationFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWebApplicationFirewallPolicy_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebApplicationFirewallPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_settings.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "policy_settings.0.mode", "Prevention"),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWebApplicationFirewallPolicy_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_web_application_firewall_policy.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWebApplicationFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWebApplicationFirewallPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebApplicationFirewallPolicyExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMWebApplicationFirewallPolicy_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_web_application_firewall_policy"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWebApplicationFirewallPolicy_complete(t *testing.T) {
+	resourceName := "azurerm_web_application_firewall_policy.test"
+	ri := testAccRandTimeInt(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWebApplicationFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWebApplicationFirewallPolicy_complete(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebApplicationFirewallPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_settings.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "policy_settings.0.mode", "Detection"),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.0.name", "BlockRemoteAddresses"),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.0.match_condition.0.operator", "IPMatch"),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.0.match_condition.0.match_values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.1.name", "BlockUserAgent"),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.1.match_condition.0.match_variable.0.selector", "UserAgent"),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.1.match_condition.0.transforms.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWebApplicationFirewallPolicy_update(t *testing.T) {
+	resourceName := "azurerm_web_application_firewall_policy.test"
+	ri := testAccRandTimeInt(t)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWebApplicationFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWebApplicationFirewallPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebApplicationFirewallPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.#", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMWebApplicationFirewallPolicy_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebApplicationFirewallPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.#", "2"),
+				),
+			},
+			{
+				Config: testAccAzureRMWebApplicationFirewallPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebApplicationFirewallPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMWebApplicationFirewallPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		id, err := ids.ParseWebApplicationFirewallPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).network().wafPoliciesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Web Application Firewall Policy %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on wafPoliciesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMWebApplicationFirewallPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).network().wafPoliciesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_web_application_firewall_policy" {
+			continue
+		}
+
+		id, err := ids.ParseWebApplicationFirewallPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Web Application Firewall Policy %q (Resource Group %q) still exists", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMWebApplicationFirewallPolicy_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_web_application_firewall_policy" "test" {
+  name                = "acctestwafpolicy%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMWebApplicationFirewallPolicy_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_application_firewall_policy" "import" {
+  name                = "${azurerm_web_application_firewall_policy.test.name}"
+  resource_group_name = "${azurerm_web_application_firewall_policy.test.resource_group_name}"
+  location            = "${azurerm_web_application_firewall_policy.test.location}"
+}
+`, testAccAzureRMWebApplicationFirewallPolicy_basic(rInt, location))
+}
+
+func testAccAzureRMWebApplicationFirewallPolicy_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_web_application_firewall_policy" "test" {
+  name                = "acctestwafpolicy%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  policy_settings {
+    enabled = true
+    mode    = "Detection"
+  }
+
+  custom_rule {
+    name      = "BlockRemoteAddresses"
+    priority  = 1
+    rule_type = "MatchRule"
+    action    = "Block"
+
+    match_condition {
+      match_variable {
+        variable_name = "RemoteAddr"
+      }
+
+      operator     = "IPMatch"
+      match_values = ["192.168.1.0/24", "10.0.0.0/24"]
+    }
+  }
+
+  custom_rule {
+    name     = "BlockUserAgent"
+    priority = 2
+    action   = "Block"
+
+    match_condition {
+      match_variable {
+        variable_name = "RequestHeaders"
+        selector      = "UserAgent"
+      }
+
+      operator           = "Contains"
+      negation_condition = false
+      match_values       = ["windows"]
+      transforms         = ["Lowercase"]
+    }
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, rInt, location, rInt)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
 )
 
 //PLEASE NOTE: This code has  been moved to terraform-provider-azurerm/azurerm/helpers/azure
@@ -39,19 +40,19 @@ func parseAzureResourceID(id string) (*ResourceID, error) {
 }
 
 func parseNetworkSecurityGroupName(networkSecurityGroupId string) (string, error) {
-	id, err := parseAzureResourceID(networkSecurityGroupId)
+	id, err := ids.ParseNetworkSecurityGroupID(networkSecurityGroupId)
 	if err != nil {
 		return "", fmt.Errorf("[ERROR] Unable to Parse Network Security Group ID '%s': %+v", networkSecurityGroupId, err)
 	}
 
-	return id.Path["networkSecurityGroups"], nil
+	return id.Name, nil
 }
 
 func parseRouteTableName(routeTableId string) (string, error) {
-	id, err := parseAzureResourceID(routeTableId)
+	id, err := ids.ParseRouteTableID(routeTableId)
 	if err != nil {
 		return "", fmt.Errorf("[ERROR] Unable to parse Route Table ID '%s': %+v", routeTableId, err)
 	}
 
-	return id.Path["routeTables"], nil
+	return id.Name, nil
 }
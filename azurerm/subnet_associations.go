@@ -0,0 +1,56 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/ids"
+)
+
+// subnetAssociationId returns the ID of an association between a Subnet and another resource (e.g. a Route Table),
+// which is in the format `{subnetId}|{associatedResourceId}` - such that it's distinct from the ID of the Subnet
+func subnetAssociationId(subnetId string, associatedResourceId string) string {
+	return fmt.Sprintf("%s|%s", subnetId, associatedResourceId)
+}
+
+// parseSubnetAssociationId parses the ID of an association between a Subnet and another resource, returning
+// the parsed ID of the Subnet and the ID of the associated resource
+func parseSubnetAssociationId(input string) (*ids.SubnetID, string, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, "", fmt.Errorf("Expected ID to be in the format {subnetId}|{associatedResourceId} but got %q", input)
+	}
+
+	subnetId, err := ids.ParseSubnetID(segments[0])
+	if err != nil {
+		return nil, "", fmt.Errorf("Error parsing Subnet ID %q: %+v", segments[0], err)
+	}
+
+	return subnetId, segments[1], nil
+}
+
+// migrateSubnetAssociationStateV0toV1 migrates the ID of an association between a Subnet and another resource from
+// the ID of the Subnet to the composite ID `{subnetId}|{associatedResourceId}`
+func migrateSubnetAssociationStateV0toV1(is *terraform.InstanceState, associatedResourceIdField string) (*terraform.InstanceState, error) {
+	if is.Empty() {
+		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
+		return is, nil
+	}
+
+	log.Printf("[DEBUG] ARM Subnet Association Attributes before Migration: %#v", is.Attributes)
+
+	associatedResourceId := is.Attributes[associatedResourceIdField]
+	if associatedResourceId == "" {
+		return is, fmt.Errorf("Error migrating Subnet Association %q: `%s` was empty", is.ID, associatedResourceIdField)
+	}
+
+	newID := subnetAssociationId(is.ID, associatedResourceId)
+	is.Attributes["id"] = newID
+	is.ID = newID
+
+	log.Printf("[DEBUG] ARM Subnet Association Attributes after State Migration: %#v", is.Attributes)
+
+	return is, nil
+}
@@ -0,0 +1,113 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestParseSubnetAssociationId(t *testing.T) {
+	subnetId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"
+	routeTableId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/routeTables/table1"
+
+	testData := []struct {
+		Name        string
+		Input       string
+		Expected    string
+		ExpectError bool
+	}{
+		{
+			Name:        "Empty",
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Name:        "Subnet ID",
+			Input:       subnetId,
+			ExpectError: true,
+		},
+		{
+			Name:        "Not a Subnet ID",
+			Input:       routeTableId + "|" + routeTableId,
+			ExpectError: true,
+		},
+		{
+			Name:        "Too Many Segments",
+			Input:       subnetId + "|" + routeTableId + "|" + routeTableId,
+			ExpectError: true,
+		},
+		{
+			Name:     "Association ID",
+			Input:    subnetId + "|" + routeTableId,
+			Expected: routeTableId,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		id, associatedId, err := parseSubnetAssociationId(v.Input)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Expected no error for %q but got: %+v", v.Name, err)
+		}
+
+		if v.ExpectError {
+			t.Fatalf("Expected an error for %q but didn't get one", v.Name)
+		}
+
+		if id.Name != "subnet1" {
+			t.Fatalf("Expected the Subnet Name to be %q but got %q", "subnet1", id.Name)
+		}
+
+		if associatedId != v.Expected {
+			t.Fatalf("Expected the Associated ID to be %q but got %q", v.Expected, associatedId)
+		}
+	}
+}
+
+func TestMigrateSubnetAssociationStateV0toV1(t *testing.T) {
+	subnetId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"
+	routeTableId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/routeTables/table1"
+
+	is := &terraform.InstanceState{
+		ID: subnetId,
+		Attributes: map[string]string{
+			"id":             subnetId,
+			"subnet_id":      subnetId,
+			"route_table_id": routeTableId,
+		},
+	}
+
+	is, err := resourceArmSubnetRouteTableAssociationMigrateState(0, is, nil)
+	if err != nil {
+		t.Fatalf("Error migrating state: %+v", err)
+	}
+
+	expected := subnetId + "|" + routeTableId
+	if is.ID != expected {
+		t.Fatalf("Expected the ID to be %q but got %q", expected, is.ID)
+	}
+
+	if actual := is.Attributes["id"]; actual != expected {
+		t.Fatalf("Expected the `id` attribute to be %q but got %q", expected, actual)
+	}
+
+	if actual := is.Attributes["subnet_id"]; actual != subnetId {
+		t.Fatalf("Expected the `subnet_id` attribute to be %q but got %q", subnetId, actual)
+	}
+
+	missing := &terraform.InstanceState{
+		ID: subnetId,
+		Attributes: map[string]string{
+			"id":        subnetId,
+			"subnet_id": subnetId,
+		},
+	}
+	if _, err := resourceArmSubnetNetworkSecurityGroupAssociationMigrateState(0, missing, nil); err == nil {
+		t.Fatalf("Expected an error migrating state without a `network_security_group_id` but didn't get one")
+	}
+}
@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -114,3 +115,190 @@ func flattenAndSetTags(d *schema.ResourceData, tagMap map[string]*string) {
 
 	d.Set("tags", output)
 }
+
+// resourceSupportsDefaultTags determines whether the Default Tags specified in the Provider block are merged
+// into the `tags` of a resource - which is the case for every resource using `tagsSchema()` (or `tagsForceNewSchema()`)
+func resourceSupportsDefaultTags(resource *schema.Resource) bool {
+	tags, ok := resource.Schema["tags"]
+	return ok && tags.Type == schema.TypeMap && tags.Optional && tags.Computed
+}
+
+// withDefaultTags merges the Default Tags specified in the Provider block into the `tags` of the resource, such that
+// the diff is computed against the merged set of tags - and removes any tags whose key begins with one of the
+// ignored prefixes (for example those applied by Azure Policy) from the state, so these don't cause a diff, whilst
+// retaining these on the resource when it's updated
+func withDefaultTags(resource *schema.Resource) {
+	customizeDiff := resource.CustomizeDiff
+	resource.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(d, meta); err != nil {
+				return err
+			}
+		}
+
+		return customizeDiffForDefaultTags(d, meta)
+	}
+
+	read := resource.Read
+	resource.Create = schema.CreateFunc(withIgnoredTagsRemoved(resource.Create))
+	resource.Read = schema.ReadFunc(withIgnoredTagsRemoved(read))
+	if resource.Update != nil {
+		resource.Update = schema.UpdateFunc(withIgnoredTagsRemoved(withIgnoredTagsRetained(resource, read, resource.Update)))
+	}
+}
+
+func customizeDiffForDefaultTags(d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*ArmClient)
+	if !ok || client == nil || len(client.defaultTags) == 0 {
+		return nil
+	}
+
+	// the tags can't be merged until they're known
+	if !d.NewValueKnown("tags") {
+		return nil
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+	merged := mergeDefaultTags(client.defaultTags, tags)
+	if reflect.DeepEqual(tags, merged) {
+		return nil
+	}
+
+	if _, errors := validateAzureRMTags(merged, "tags"); len(errors) > 0 {
+		return fmt.Errorf("Error merging the Default Tags into the `tags`: %+v", errors[0])
+	}
+
+	return d.SetNew("tags", merged)
+}
+
+func withIgnoredTagsRemoved(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if err := f(d, meta); err != nil {
+			return err
+		}
+
+		client, ok := meta.(*ArmClient)
+		if !ok || client == nil || len(client.ignoreTagPrefixes) == 0 || d.Id() == "" {
+			return nil
+		}
+
+		tags := d.Get("tags").(map[string]interface{})
+		filtered := removeIgnoredTags(tags, client.ignoreTagPrefixes)
+		if len(filtered) == len(tags) {
+			return nil
+		}
+
+		return d.Set("tags", filtered)
+	}
+}
+
+// withIgnoredTagsRetained merges the tags whose key begins with one of the ignored prefixes, which aren't in the state,
+// from the resource in Azure into the `tags` prior to updating it - since the tags of a resource are replaced when it's
+// updated, these would otherwise be removed
+func withIgnoredTagsRetained(resource *schema.Resource, read schema.ReadFunc, update schema.UpdateFunc) schema.UpdateFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client, ok := meta.(*ArmClient)
+		if !ok || client == nil || len(client.ignoreTagPrefixes) == 0 {
+			return update(d, meta)
+		}
+
+		existing := resource.Data(d.State())
+		if err := read(existing, meta); err != nil {
+			return fmt.Errorf("Error retrieving the existing tags: %+v", err)
+		}
+
+		// the resource no longer exists, so there's nothing to retain
+		if existing.Id() == "" {
+			return update(d, meta)
+		}
+
+		tags := d.Get("tags").(map[string]interface{})
+		merged := mergeIgnoredTags(tags, existing.Get("tags").(map[string]interface{}), client.ignoreTagPrefixes)
+		if len(merged) != len(tags) {
+			if err := d.Set("tags", merged); err != nil {
+				return fmt.Errorf("Error retaining the ignored tags: %+v", err)
+			}
+		}
+
+		return update(d, meta)
+	}
+}
+
+// mergeDefaultTags returns the Default Tags merged with the tags specified for a resource, which take precedence
+// over a Default Tag with the same key (which, as in Azure, is case-insensitive)
+func mergeDefaultTags(defaultTags map[string]interface{}, tags map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(defaultTags)+len(tags))
+
+	for k, v := range defaultTags {
+		overridden := false
+		for key := range tags {
+			if strings.EqualFold(k, key) {
+				overridden = true
+				break
+			}
+		}
+
+		if !overridden {
+			output[k] = v
+		}
+	}
+
+	for k, v := range tags {
+		output[k] = v
+	}
+
+	return output
+}
+
+// mergeIgnoredTags returns the tags merged with the existing tags whose key begins with one of the (case-insensitive)
+// prefixes - where the tags specified for the resource take precedence over an existing tag with the same key
+func mergeIgnoredTags(tags map[string]interface{}, existing map[string]interface{}, prefixes []string) map[string]interface{} {
+	output := make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		output[k] = v
+	}
+
+	for k, v := range existing {
+		if !tagIsIgnored(k, prefixes) {
+			continue
+		}
+
+		specified := false
+		for key := range tags {
+			if strings.EqualFold(k, key) {
+				specified = true
+				break
+			}
+		}
+
+		if !specified {
+			output[k] = v
+		}
+	}
+
+	return output
+}
+
+// removeIgnoredTags returns the tags whose key doesn't begin with any of the (case-insensitive) prefixes
+func removeIgnoredTags(tags map[string]interface{}, prefixes []string) map[string]interface{} {
+	output := make(map[string]interface{}, len(tags))
+
+	for k, v := range tags {
+		if !tagIsIgnored(k, prefixes) {
+			output[k] = v
+		}
+	}
+
+	return output
+}
+
+// tagIsIgnored determines whether the key of a tag begins with any of the (case-insensitive) prefixes
+func tagIsIgnored(key string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(strings.ToLower(key), strings.ToLower(prefix)) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -2,8 +2,13 @@ package azurerm
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestValidateMaximumNumberOfARMTags(t *testing.T) {
@@ -113,3 +118,182 @@ func TestFilterARMTags(t *testing.T) {
 		t.Fatalf("Expected %v in filtered tag map, got %v", valueData[1], *filtered["key2"])
 	}
 }
+
+func TestMergeDefaultTags(t *testing.T) {
+	defaultTags := map[string]interface{}{
+		"environment": "Production",
+		"CostCenter":  "Finance",
+	}
+	tags := map[string]interface{}{
+		"costcenter": "Engineering",
+		"owner":      "Networking",
+	}
+
+	merged := mergeDefaultTags(defaultTags, tags)
+
+	expected := map[string]interface{}{
+		"environment": "Production",
+		"costcenter":  "Engineering",
+		"owner":       "Networking",
+	}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Fatalf("Expected the merged tags to be %+v but got %+v", expected, merged)
+	}
+}
+
+func TestRemoveIgnoredTags(t *testing.T) {
+	tags := map[string]interface{}{
+		"environment":      "Production",
+		"Hidden-Link:Site": "example",
+		"hidden-title":     "Example",
+	}
+
+	filtered := removeIgnoredTags(tags, []string{"hidden-"})
+
+	expected := map[string]interface{}{
+		"environment": "Production",
+	}
+	if !reflect.DeepEqual(filtered, expected) {
+		t.Fatalf("Expected the filtered tags to be %+v but got %+v", expected, filtered)
+	}
+}
+
+func TestMergeIgnoredTags(t *testing.T) {
+	tags := map[string]interface{}{
+		"environment":  "Production",
+		"hidden-title": "Specified",
+	}
+	existing := map[string]interface{}{
+		"environment":      "Development",
+		"Hidden-Link:Site": "example",
+		"HIDDEN-TITLE":     "Existing",
+		"owner":            "Networking",
+	}
+
+	merged := mergeIgnoredTags(tags, existing, []string{"hidden-"})
+
+	expected := map[string]interface{}{
+		"environment":      "Production",
+		"hidden-title":     "Specified",
+		"Hidden-Link:Site": "example",
+	}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Fatalf("Expected the merged tags to be %+v but got %+v", expected, merged)
+	}
+}
+
+func TestIgnoredTags_update(t *testing.T) {
+	remote := map[string]interface{}{
+		"environment":   "Production",
+		"hidden-policy": "applied",
+	}
+	var updated map[string]*string
+
+	resource := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return d.Set("tags", remote)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			updated = expandTags(d.Get("tags").(map[string]interface{}))
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Schema: map[string]*schema.Schema{
+			"tags": tagsSchema(),
+		},
+	}
+	withDefaultTags(resource)
+
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"tags": map[string]interface{}{
+			"environment": "Development",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error building the config: %+v", err)
+	}
+
+	client := &ArmClient{
+		ignoreTagPrefixes: []string{"hidden-"},
+	}
+	state := &terraform.InstanceState{
+		ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+		Attributes: map[string]string{
+			"id":               "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			"tags.%":           "1",
+			"tags.environment": "Production",
+		},
+	}
+	diff, err := resource.Diff(state, terraform.NewResourceConfig(raw), client)
+	if err != nil {
+		t.Fatalf("Error computing the diff: %+v", err)
+	}
+
+	newState, err := resource.Apply(state, diff, client)
+	if err != nil {
+		t.Fatalf("Error applying the diff: %+v", err)
+	}
+
+	if len(updated) != 2 || *updated["environment"] != "Development" || updated["hidden-policy"] == nil || *updated["hidden-policy"] != "applied" {
+		t.Fatalf("Expected the ignored tag to be retained when updating the tags but got %+v", updated)
+	}
+
+	if _, ok := newState.Attributes["tags.hidden-policy"]; ok {
+		t.Fatalf("Expected the ignored tag not to be in the state but got %+v", newState.Attributes)
+	}
+}
+
+func TestDefaultTags_diff(t *testing.T) {
+	resource := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Read:   func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Delete: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Schema: map[string]*schema.Schema{
+			"tags": tagsSchema(),
+		},
+	}
+	withDefaultTags(resource)
+
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"tags": map[string]interface{}{
+			"Environment": "Development",
+			"owner":       "Networking",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error building the config: %+v", err)
+	}
+
+	client := &ArmClient{
+		defaultTags: map[string]interface{}{
+			"environment": "Production",
+			"costCenter":  "Finance",
+		},
+	}
+	diff, err := resource.Diff(nil, terraform.NewResourceConfig(raw), client)
+	if err != nil {
+		t.Fatalf("Error computing the diff: %+v", err)
+	}
+
+	expected := map[string]string{
+		"tags.%":           "3",
+		"tags.Environment": "Development",
+		"tags.owner":       "Networking",
+		"tags.costCenter":  "Finance",
+	}
+	for key, value := range expected {
+		attribute, ok := diff.Attributes[key]
+		if !ok {
+			t.Fatalf("Expected a diff for %q but didn't get one", key)
+		}
+
+		if attribute.New != value {
+			t.Fatalf("Expected %q to be %q but got %q", key, value, attribute.New)
+		}
+	}
+
+	if _, ok := diff.Attributes["tags.environment"]; ok {
+		t.Fatalf("Expected the Default Tag `environment` to be overridden by the tag `Environment`")
+	}
+}